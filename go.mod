@@ -18,6 +18,7 @@ require (
 	github.com/robfig/cron/v3 v3.0.1
 	github.com/scaleway/scaleway-sdk-go v1.0.0-beta.20.0.20230807090124-eefdeb5d74c7
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/sync v0.3.0
 )
 
 require (
@@ -0,0 +1,36 @@
+package scaleway
+
+import (
+	"net/http"
+)
+
+const scwMaxConcurrentRequestsEnv = "SCW_MAX_CONCURRENT_REQUESTS"
+
+// concurrencyLimitedTransport limits the number of API requests running at the same time.
+// Requests waiting for a slot are cancelled with their context.
+type concurrencyLimitedTransport struct {
+	transport http.RoundTripper
+	slots     chan struct{}
+}
+
+// newConcurrencyLimitedTransport returns transport unchanged if maxConcurrentRequests is not strictly positive.
+func newConcurrencyLimitedTransport(transport http.RoundTripper, maxConcurrentRequests int) http.RoundTripper {
+	if maxConcurrentRequests <= 0 {
+		return transport
+	}
+	return &concurrencyLimitedTransport{
+		transport: transport,
+		slots:     make(chan struct{}, maxConcurrentRequests),
+	}
+}
+
+func (t *concurrencyLimitedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-r.Context().Done():
+		return nil, r.Context().Err()
+	}
+	defer func() { <-t.slots }()
+
+	return t.transport.RoundTrip(r)
+}
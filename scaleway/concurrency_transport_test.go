@@ -0,0 +1,103 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestConcurrencyLimitedTransport(t *testing.T) {
+	const maxConcurrentRequests = 2
+
+	var running, maxRunning int32
+	transport := newConcurrencyLimitedTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			observed := atomic.LoadInt32(&maxRunning)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), maxConcurrentRequests)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://api.scaleway.com", nil)
+			_, err := transport.RoundTrip(req)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxRunning, int32(maxConcurrentRequests))
+}
+
+func TestConcurrencyLimitedTransportCancelledContext(t *testing.T) {
+	const timeout = 5 * time.Second
+
+	started := make(chan struct{})
+	block := make(chan struct{})
+	transport := newConcurrencyLimitedTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		close(started)
+		<-block
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), 1)
+
+	// The first request holds the only slot until block is closed
+	firstDone := make(chan error, 1)
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.scaleway.com", nil)
+		_, err := transport.RoundTrip(req)
+		firstDone <- err
+	}()
+	select {
+	case <-started:
+	case <-time.After(timeout):
+		t.Fatal("first request did not start")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	secondDone := make(chan error, 1)
+	go func() {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.scaleway.com", nil)
+		_, err := transport.RoundTrip(req)
+		secondDone <- err
+	}()
+	cancel()
+
+	select {
+	case err := <-secondDone:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(timeout):
+		t.Fatal("waiting request was not cancelled")
+	}
+
+	close(block)
+	select {
+	case err := <-firstDone:
+		assert.NoError(t, err)
+	case <-time.After(timeout):
+		t.Fatal("first request did not complete")
+	}
+}
+
+func TestNewConcurrencyLimitedTransportUnlimited(t *testing.T) {
+	assert.Equal(t, http.DefaultTransport, newConcurrencyLimitedTransport(http.DefaultTransport, 0))
+}
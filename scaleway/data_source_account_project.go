@@ -43,9 +43,9 @@ func dataSourceScalewayAccountProjectRead(ctx context.Context, d *schema.Resourc
 		res, err := accountAPI.ListProjects(&accountV3.ProjectAPIListProjectsRequest{
 			OrganizationID: *orgID,
 			Name:           expandStringPtr(name),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, project := range res.Projects {
 			if project.Name == name {
@@ -65,7 +65,7 @@ func dataSourceScalewayAccountProjectRead(ctx context.Context, d *schema.Resourc
 	} else {
 		extractedProjectID, _, err := extractProjectID(d, meta.(*Meta))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		projectID = extractedProjectID
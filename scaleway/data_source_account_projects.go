@@ -0,0 +1,99 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	accountV3 "github.com/scaleway/scaleway-sdk-go/api/account/v3"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayAccountProjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayAccountProjectsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Projects with a name like it are listed.",
+			},
+			"organization_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The organization ID the projects are listed from",
+				ValidateFunc: validationUUID(),
+			},
+			"projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"description": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"updated_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"organization_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayAccountProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accountAPI := accountV3ProjectAPI(meta)
+
+	orgID := getOrganizationID(meta, d)
+	if orgID == nil {
+		// required not in schema as we could use default
+		return diag.Errorf("organization_id is required")
+	}
+
+	res, err := accountAPI.ListProjects(&accountV3.ProjectAPIListProjectsRequest{
+		OrganizationID: *orgID,
+		Name:           expandStringPtr(d.Get("name")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	projects := []interface{}(nil)
+	for _, project := range res.Projects {
+		rawProject := make(map[string]interface{})
+		rawProject["id"] = project.ID
+		rawProject["name"] = project.Name
+		rawProject["description"] = project.Description
+		rawProject["created_at"] = flattenTime(project.CreatedAt)
+		rawProject["updated_at"] = flattenTime(project.UpdatedAt)
+		rawProject["organization_id"] = project.OrganizationID
+
+		projects = append(projects, rawProject)
+	}
+
+	d.SetId(*orgID)
+	_ = d.Set("organization_id", *orgID)
+	_ = d.Set("projects", projects)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceAccountProjects_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	orgID, orgIDExists := tt.Meta.scwClient.GetDefaultOrganizationID()
+	if !orgIDExists {
+		orgID = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayAccountProjectDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource scaleway_account_project "project" {
+						name = "tf-tests-terraform-account-projects"
+					}
+
+					data scaleway_account_projects "by_name" {
+						name = scaleway_account_project.project.name
+						organization_id = "%s"
+					}
+
+					data scaleway_account_projects "all" {
+						organization_id = "%s"
+						depends_on = [scaleway_account_project.project]
+					}`, orgID, orgID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_account_projects.by_name", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_account_projects.by_name", "projects.0.id", "scaleway_account_project.project", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_account_projects.by_name", "projects.0.name", "scaleway_account_project.project", "name"),
+					resource.TestCheckResourceAttr("data.scaleway_account_projects.by_name", "organization_id", orgID),
+					resource.TestCheckResourceAttrSet("data.scaleway_account_projects.all", "projects.1.id"),
+				),
+			},
+		},
+	})
+}
@@ -26,6 +26,11 @@ func DataSourceAvailabilityZones() *schema.Resource {
 				Description: "Region is represented as a Geographical area such as France",
 				Default:     scw.RegionFrPar,
 			},
+			"product": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the zones where this product is available are listed",
+			},
 			"zones": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -42,12 +47,17 @@ func dataSourceAvailabilityZonesRead(_ context.Context, d *schema.ResourceData,
 	regionStr := d.Get("region").(string)
 
 	if !validation.IsRegion(regionStr) {
-		return diag.FromErr(SingularDataSourceFindError("Availability Zone", fmt.Errorf("not a supported region %s", regionStr)))
+		return diagFromErr(SingularDataSourceFindError("Availability Zone", fmt.Errorf("not a supported region %s", regionStr)))
 	}
 
 	region := scw.Region(regionStr)
+	zones, err := zonesForProduct(region, d.Get("product").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
 	d.SetId(regionStr)
-	_ = d.Set("zones", region.GetZones())
+	_ = d.Set("zones", zones)
 
 	return nil
 }
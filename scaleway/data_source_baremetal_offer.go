@@ -146,7 +146,7 @@ func dataSourceScalewayBaremetalOffer() *schema.Resource {
 func dataSourceScalewayBaremetalOfferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	baremetalAPI, fallBackZone, err := baremetalAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	zone, offerID, _ := parseZonedID(datasourceNewZonedID(d.Get("offer_id"), fallBackZone))
@@ -157,7 +157,7 @@ func dataSourceScalewayBaremetalOfferRead(ctx context.Context, d *schema.Resourc
 		// Temporary fix because GetOffer doesn't fetch monthly subscription offers
 		offer, err = baremetalFindOfferByID(ctx, baremetalAPI, zone, offerID)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	} else {
 		listOffersRequest := &baremetal.ListOffersRequest{
@@ -169,14 +169,14 @@ func dataSourceScalewayBaremetalOfferRead(ctx context.Context, d *schema.Resourc
 
 		res, err := baremetalAPI.ListOffers(listOffersRequest, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		matches := []*baremetal.Offer{}
 		for _, offer := range res.Offers {
 			if offer.Name == d.Get("name") {
 				if !offer.Enable && !d.Get("include_disabled").(bool) {
-					return diag.FromErr(fmt.Errorf("%s offer %s (%s) found in zone %s but is disabled. Add allow_disabled=true in your terraform config to use it", offer.SubscriptionPeriod, offer.Name, offer.ID, zone))
+					return diagFromErr(fmt.Errorf("%s offer %s (%s) found in zone %s but is disabled. Add allow_disabled=true in your terraform config to use it", offer.SubscriptionPeriod, offer.Name, offer.ID, zone))
 				}
 
 				matches = append(matches, offer)
@@ -185,18 +185,18 @@ func dataSourceScalewayBaremetalOfferRead(ctx context.Context, d *schema.Resourc
 
 		if len(matches) == 0 {
 			if subscriptionPeriod, ok := d.GetOk("subscription_period"); ok {
-				return diag.FromErr(fmt.Errorf("no offer found with the name %s and %s subscription period in zone %s", d.Get("name"), subscriptionPeriod, zone))
+				return diagFromErr(fmt.Errorf("no offer found with the name %s and %s subscription period in zone %s", d.Get("name"), subscriptionPeriod, zone))
 			}
 
-			return diag.FromErr(fmt.Errorf("no offer found with the name %s in zone %s", d.Get("name"), zone))
+			return diagFromErr(fmt.Errorf("no offer found with the name %s in zone %s", d.Get("name"), zone))
 		}
 
 		if len(matches) > 1 {
 			if subscriptionPeriod, ok := d.GetOk("subscription_period"); ok {
-				return diag.FromErr(fmt.Errorf("%d offers found with the same name %s and %s subscription period in zone %s", len(matches), d.Get("name"), subscriptionPeriod, zone))
+				return diagFromErr(fmt.Errorf("%d offers found with the same name %s and %s subscription period in zone %s", len(matches), d.Get("name"), subscriptionPeriod, zone))
 			}
 
-			return diag.FromErr(fmt.Errorf("%d offers found with the same name %s in zone %s", len(matches), d.Get("name"), zone))
+			return diagFromErr(fmt.Errorf("%d offers found with the same name %s in zone %s", len(matches), d.Get("name"), zone))
 		}
 
 		offer = matches[0]
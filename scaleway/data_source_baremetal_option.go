@@ -40,7 +40,7 @@ func dataSourceScalewayBaremetalOption() *schema.Resource {
 func dataSourceScalewayBaremetalOptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := baremetalAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var optionName string
@@ -53,7 +53,7 @@ func dataSourceScalewayBaremetalOptionRead(ctx context.Context, d *schema.Resour
 			OptionID: optionID.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		optionManageable = res.Manageable
 		optionName = res.Name
@@ -62,10 +62,10 @@ func dataSourceScalewayBaremetalOptionRead(ctx context.Context, d *schema.Resour
 			Zone: zone,
 		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Options) == 0 {
-			return diag.FromErr(fmt.Errorf("no option found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no option found with the name %s", d.Get("name")))
 		}
 		for _, option := range res.Options {
 			if option.Name == d.Get("name") {
@@ -41,7 +41,7 @@ func dataSourceScalewayBaremetalOs() *schema.Resource {
 func dataSourceScalewayBaremetalOsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := baremetalAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var osVersion, osName string
@@ -54,7 +54,7 @@ func dataSourceScalewayBaremetalOsRead(ctx context.Context, d *schema.ResourceDa
 			OsID: osID.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		osVersion = res.Version
 		osName = res.Name
@@ -64,10 +64,10 @@ func dataSourceScalewayBaremetalOsRead(ctx context.Context, d *schema.ResourceDa
 			Zone: zone,
 		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Os) == 0 {
-			return diag.FromErr(fmt.Errorf("no os found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no os found with the name %s", d.Get("name")))
 		}
 		for _, os := range res.Os {
 			if os.Name == d.Get("name") && os.Version == d.Get("version") {
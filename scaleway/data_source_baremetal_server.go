@@ -16,6 +16,7 @@ func dataSourceScalewayBaremetalServer() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "zone")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"server_id"}
 	dsSchema["server_id"] = &schema.Schema{
@@ -35,23 +36,24 @@ func dataSourceScalewayBaremetalServer() *schema.Resource {
 func dataSourceScalewayBaremetalServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := baremetalAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	serverID, ok := d.GetOk("server_id")
 	if !ok { // Get server by zone and name.
 		res, err := api.ListServers(&baremetal.ListServersRequest{
-			Zone: zone,
-			Name: scw.StringPtr(d.Get("name").(string)),
-		}, scw.WithContext(ctx))
+			Zone:      zone,
+			Name:      scw.StringPtr(d.Get("name").(string)),
+			ProjectID: expandStringPtr(d.Get("project_id")),
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Servers) == 0 {
-			return diag.FromErr(fmt.Errorf("no servers found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no servers found with the name %s", d.Get("name")))
 		}
 		if len(res.Servers) > 1 {
-			return diag.FromErr(fmt.Errorf("%d servers found with the same name %s", len(res.Servers), d.Get("name")))
+			return diagFromErr(fmt.Errorf("%d servers found with the same name %s", len(res.Servers), d.Get("name")))
 		}
 		serverID = res.Servers[0].ID
 	}
@@ -60,7 +62,7 @@ func dataSourceScalewayBaremetalServerRead(ctx context.Context, d *schema.Resour
 	d.SetId(zoneID)
 	err = d.Set("server_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	diags := resourceScalewayBaremetalServerRead(ctx, d, meta)
 	if diags != nil {
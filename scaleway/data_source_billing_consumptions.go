@@ -0,0 +1,127 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	billing "github.com/scaleway/scaleway-sdk-go/api/billing/v2alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayBillingConsumptions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayBillingConsumptionsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only consumptions of this project are listed.",
+				ValidateFunc: validationUUID(),
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only consumptions of this category are listed.",
+			},
+			"organization_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The organization ID the consumptions are listed from",
+				ValidateFunc: validationUUID(),
+			},
+			"consumptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"amount": {
+							Computed: true,
+							Type:     schema.TypeFloat,
+						},
+						"currency": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"description": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"project_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"category": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"operation_path": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of the last update of the consumptions (RFC 3339 format)",
+			},
+		},
+	}
+}
+
+func dataSourceScalewayBillingConsumptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := billingAPI(meta)
+
+	orgID := getOrganizationID(meta, d)
+	if orgID == nil {
+		// required not in schema as we could use default
+		return diag.Errorf("organization_id is required")
+	}
+
+	res, err := api.GetConsumption(&billing.GetConsumptionRequest{
+		OrganizationID: *orgID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	category := d.Get("category").(string)
+
+	consumptions := []interface{}(nil)
+	for _, consumption := range res.Consumptions {
+		if projectID != "" && consumption.ProjectID != projectID {
+			continue
+		}
+		if category != "" && consumption.Category != category {
+			continue
+		}
+
+		rawConsumption := make(map[string]interface{})
+		if consumption.Value != nil {
+			rawConsumption["value"] = consumption.Value.String()
+			rawConsumption["amount"] = consumption.Value.ToFloat()
+			rawConsumption["currency"] = consumption.Value.CurrencyCode
+		}
+		rawConsumption["description"] = consumption.Description
+		rawConsumption["project_id"] = consumption.ProjectID
+		rawConsumption["category"] = consumption.Category
+		rawConsumption["operation_path"] = consumption.OperationPath
+
+		consumptions = append(consumptions, rawConsumption)
+	}
+
+	d.SetId(*orgID)
+	_ = d.Set("organization_id", *orgID)
+	_ = d.Set("consumptions", consumptions)
+	_ = d.Set("updated_at", flattenTime(res.UpdatedAt))
+
+	return nil
+}
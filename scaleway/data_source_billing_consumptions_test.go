@@ -0,0 +1,38 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceBillingConsumptions_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_billing_consumptions" "all" {
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+
+					data "scaleway_billing_consumptions" "by_category" {
+					  category = data.scaleway_billing_consumptions.all.consumptions.0.category
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scaleway_billing_consumptions.all", "updated_at"),
+					resource.TestCheckResourceAttrSet("data.scaleway_billing_consumptions.all", "consumptions.0.value"),
+					resource.TestCheckResourceAttrSet("data.scaleway_billing_consumptions.all", "consumptions.0.project_id"),
+					resource.TestCheckResourceAttrSet("data.scaleway_billing_consumptions.all", "consumptions.0.category"),
+
+					resource.TestCheckResourceAttrPair("data.scaleway_billing_consumptions.by_category", "consumptions.0.category", "data.scaleway_billing_consumptions.all", "consumptions.0.category"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	billing "github.com/scaleway/scaleway-sdk-go/api/billing/v2alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayBillingInvoices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayBillingInvoicesRead,
+		Schema: map[string]*schema.Schema{
+			"started_after": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Invoices with a start date that are greater or equal to this date (RFC 3339 format) are listed.",
+				ValidateDiagFunc: validateDate(),
+			},
+			"invoice_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Invoices with this type are listed.",
+				ValidateFunc: validation.StringInSlice([]string{
+					billing.InvoiceTypePeriodic.String(),
+					billing.InvoiceTypePurchase.String(),
+				}, false),
+			},
+			"organization_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The organization ID the invoices are listed from",
+				ValidateFunc: validationUUID(),
+			},
+			"invoices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"number": {
+							Computed: true,
+							Type:     schema.TypeInt,
+						},
+						"invoice_type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"start_date": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"issued_date": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"due_date": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"total_untaxed": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"total_taxed": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayBillingInvoicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := billingAPI(meta)
+
+	orgID := getOrganizationID(meta, d)
+	if orgID == nil {
+		// required not in schema as we could use default
+		return diag.Errorf("organization_id is required")
+	}
+
+	res, err := api.ListInvoices(&billing.ListInvoicesRequest{
+		OrganizationID: orgID,
+		InvoiceType:    billing.InvoiceType(d.Get("invoice_type").(string)),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	startedAfter := expandTimePtr(d.Get("started_after"))
+
+	invoices := []interface{}(nil)
+	for _, invoice := range res.Invoices {
+		if startedAfter != nil && (invoice.StartDate == nil || invoice.StartDate.Before(*startedAfter)) {
+			continue
+		}
+
+		rawInvoice := make(map[string]interface{})
+		rawInvoice["id"] = invoice.ID
+		rawInvoice["number"] = int(invoice.Number)
+		rawInvoice["invoice_type"] = invoice.InvoiceType.String()
+		rawInvoice["start_date"] = flattenTime(invoice.StartDate)
+		rawInvoice["issued_date"] = flattenTime(invoice.IssuedDate)
+		rawInvoice["due_date"] = flattenTime(invoice.DueDate)
+		if invoice.TotalUntaxed != nil {
+			rawInvoice["total_untaxed"] = invoice.TotalUntaxed.String()
+		}
+		if invoice.TotalTaxed != nil {
+			rawInvoice["total_taxed"] = invoice.TotalTaxed.String()
+		}
+
+		invoices = append(invoices, rawInvoice)
+	}
+
+	d.SetId(*orgID)
+	_ = d.Set("organization_id", *orgID)
+	_ = d.Set("invoices", invoices)
+
+	return nil
+}
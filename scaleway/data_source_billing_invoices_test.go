@@ -0,0 +1,44 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceBillingInvoices_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_billing_invoices" "all" {
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+
+					data "scaleway_billing_invoices" "periodic" {
+					  invoice_type = "periodic"
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+
+					data "scaleway_billing_invoices" "future" {
+					  started_after = "2100-01-01T00:00:00Z"
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scaleway_billing_invoices.all", "invoices.0.id"),
+					resource.TestCheckResourceAttrSet("data.scaleway_billing_invoices.all", "invoices.0.start_date"),
+					resource.TestCheckResourceAttrSet("data.scaleway_billing_invoices.all", "invoices.0.total_taxed"),
+
+					resource.TestCheckResourceAttr("data.scaleway_billing_invoices.periodic", "invoices.0.invoice_type", "periodic"),
+
+					resource.TestCheckResourceAttr("data.scaleway_billing_invoices.future", "invoices.#", "0"),
+				),
+			},
+		},
+	})
+}
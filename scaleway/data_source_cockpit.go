@@ -28,20 +28,21 @@ func dataSourceScalewayCockpit() *schema.Resource {
 func dataSourceScalewayCockpitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := cockpitAPI(meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	projectID := d.Get("project_id").(string)
 
 	res, err := waitForCockpit(ctx, api, projectID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(res.ProjectID)
 	_ = d.Set("project_id", res.ProjectID)
 	_ = d.Set("plan_id", res.Plan.ID)
 	_ = d.Set("endpoints", flattenCockpitEndpoints(res.Endpoints))
+	_ = d.Set("push_url", flattenCockpitPushURL(res.Endpoints))
 
 	return nil
 }
@@ -25,14 +25,14 @@ func dataSourceScalewayCockpitPlan() *schema.Resource {
 func dataSourceScalewayCockpitPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := cockpitAPI(meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	name := d.Get("name").(string)
 
 	res, err := api.ListPlans(&cockpit.ListPlansRequest{}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var plan *cockpit.Plan
@@ -40,7 +40,7 @@ func dataSourceScalewayContainer() *schema.Resource {
 func dataSourceScalewayContainerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := containerAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	containerID, ok := d.GetOk("container_id")
@@ -50,15 +50,15 @@ func dataSourceScalewayContainerRead(ctx context.Context, d *schema.ResourceData
 			Region:      region,
 			Name:        expandStringPtr(d.Get("name")),
 			NamespaceID: expandID(namespaceID),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Containers) == 0 {
-			return diag.FromErr(fmt.Errorf("no container found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no container found with the name %s", d.Get("name")))
 		}
 		if len(res.Containers) > 1 {
-			return diag.FromErr(fmt.Errorf("%d container found with the same name %s", len(res.Containers), d.Get("name")))
+			return diagFromErr(fmt.Errorf("%d container found with the same name %s", len(res.Containers), d.Get("name")))
 		}
 		containerID = res.Containers[0].ID
 	}
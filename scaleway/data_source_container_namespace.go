@@ -15,6 +15,7 @@ func dataSourceScalewayContainerNamespace() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayContainerNamespace().Schema)
 
 	addOptionalFieldsToSchema(dsSchema, "name", "region")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"namespace_id"}
 	dsSchema["namespace_id"] = &schema.Schema{
@@ -34,23 +35,24 @@ func dataSourceScalewayContainerNamespace() *schema.Resource {
 func dataSourceScalewayContainerNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := containerAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	namespaceID, ok := d.GetOk("namespace_id")
 	if !ok {
 		res, err := api.ListNamespaces(&container.ListNamespacesRequest{
-			Region: region,
-			Name:   expandStringPtr(d.Get("name")),
-		}, scw.WithContext(ctx))
+			Region:    region,
+			Name:      expandStringPtr(d.Get("name")),
+			ProjectID: expandStringPtr(d.Get("project_id")),
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Namespaces) == 0 {
-			return diag.FromErr(fmt.Errorf("no container namespace found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no container namespace found with the name %s", d.Get("name")))
 		}
 		if len(res.Namespaces) > 1 {
-			return diag.FromErr(fmt.Errorf("%d container namespaces found with the same name %s", len(res.Namespaces), d.Get("name")))
+			return diagFromErr(fmt.Errorf("%d container namespaces found with the same name %s", len(res.Namespaces), d.Get("name")))
 		}
 		namespaceID = res.Namespaces[0].ID
 	}
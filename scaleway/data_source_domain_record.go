@@ -16,6 +16,7 @@ func dataSourceScalewayDomainRecord() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "dns_zone", "name", "type", "data")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"record_id"}
 	dsSchema["type"].ConflictsWith = []string{"record_id"}
@@ -46,22 +47,22 @@ func dataSourceScalewayDomainRecordRead(ctx context.Context, d *schema.ResourceD
 			ProjectID: expandStringPtr(d.Get("project_id")),
 		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Records) == 0 {
-			return diag.FromErr(fmt.Errorf("no record found with the type %s", d.Get("type")))
+			return diagFromErr(fmt.Errorf("no record found with the type %s", d.Get("type")))
 		}
 		var record *domain.Record
 		for i := range res.Records {
 			if res.Records[i].Data == d.Get("data").(string) {
 				if record != nil {
-					return diag.FromErr(fmt.Errorf("more than one record found with this name: %s, type: %s and data: %s", d.Get("name"), d.Get("type"), d.Get("data")))
+					return diagFromErr(fmt.Errorf("more than one record found with this name: %s, type: %s and data: %s", d.Get("name"), d.Get("type"), d.Get("data")))
 				}
 				record = res.Records[i]
 			}
 		}
 		if record == nil {
-			return diag.FromErr(fmt.Errorf("no record found with the type this name: %s, type: %s and data: %s", d.Get("name"), d.Get("type"), d.Get("data")))
+			return diagFromErr(fmt.Errorf("no record found with the type this name: %s, type: %s and data: %s", d.Get("name"), d.Get("type"), d.Get("data")))
 		}
 		recordID = record.ID
 	}
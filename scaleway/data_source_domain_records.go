@@ -0,0 +1,111 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayDomainRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayDomainRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"dns_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The zone you want to list the records of",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Records with this name are listed.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Records with this type are listed.",
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"data": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"ttl": {
+							Computed: true,
+							Type:     schema.TypeInt,
+						},
+						"priority": {
+							Computed: true,
+							Type:     schema.TypeInt,
+						},
+						"comment": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"project_id": datasourceProjectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayDomainRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	domainAPI := newDomainAPI(meta)
+	dnsZone := d.Get("dns_zone").(string)
+
+	// the type is filtered here as an empty type would be sent as "unknown"
+	res, err := domainAPI.ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
+		DNSZone:   dnsZone,
+		Name:      d.Get("name").(string),
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	recordType := d.Get("type").(string)
+	records := []interface{}(nil)
+	for _, record := range res.Records {
+		if recordType != "" && !strings.EqualFold(record.Type.String(), recordType) {
+			continue
+		}
+
+		rawRecord := make(map[string]interface{})
+		rawRecord["id"] = fmt.Sprintf("%s/%s", dnsZone, record.ID)
+		rawRecord["name"] = record.Name
+		rawRecord["type"] = record.Type.String()
+		rawRecord["data"] = flattenDomainData(record.Data, record.Type)
+		rawRecord["ttl"] = int(record.TTL)
+		rawRecord["priority"] = int(record.Priority)
+		rawRecord["comment"] = flattenStringPtr(record.Comment)
+
+		records = append(records, rawRecord)
+	}
+
+	d.SetId(dnsZone)
+	_ = d.Set("records", records)
+
+	return nil
+}
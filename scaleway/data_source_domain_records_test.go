@@ -0,0 +1,81 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceDomainRecords_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	recordsConfig := fmt.Sprintf(`
+		resource "scaleway_domain_record" "a" {
+			dns_zone = "test-data-source-records.%[1]s"
+			name     = "www"
+			type     = "A"
+			data     = "1.2.3.4"
+			ttl      = 3600
+		}
+
+		resource "scaleway_domain_record" "txt" {
+			dns_zone = "test-data-source-records.%[1]s"
+			name     = "www"
+			type     = "TXT"
+			data     = "tf-test-data-source-records"
+			ttl      = 3600
+		}
+
+		resource "scaleway_domain_record" "mx" {
+			dns_zone = "test-data-source-records.%[1]s"
+			name     = ""
+			type     = "MX"
+			data     = "mx.%[1]s."
+			ttl      = 3600
+			priority = 10
+		}
+	`, testDomain)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayDomainRecordDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: recordsConfig,
+			},
+			{
+				Config: recordsConfig + `
+					data "scaleway_domain_records" "by_name" {
+						dns_zone = scaleway_domain_record.a.dns_zone
+						name     = "www"
+					}
+
+					data "scaleway_domain_records" "by_type" {
+						dns_zone = scaleway_domain_record.a.dns_zone
+						name     = "www"
+						type     = "txt"
+					}
+
+					data "scaleway_domain_records" "mx" {
+						dns_zone = scaleway_domain_record.mx.dns_zone
+						type     = "MX"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_domain_records.by_name", "records.#", "2"),
+
+					resource.TestCheckResourceAttr("data.scaleway_domain_records.by_type", "records.#", "1"),
+					resource.TestCheckResourceAttrSet("data.scaleway_domain_records.by_type", "records.0.id"),
+					resource.TestCheckResourceAttr("data.scaleway_domain_records.by_type", "records.0.type", "TXT"),
+					resource.TestCheckResourceAttr("data.scaleway_domain_records.by_type", "records.0.data", "tf-test-data-source-records"),
+					resource.TestCheckResourceAttr("data.scaleway_domain_records.by_type", "records.0.ttl", "3600"),
+
+					resource.TestCheckResourceAttr("data.scaleway_domain_records.mx", "records.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_domain_records.mx", "records.0.data", "scaleway_domain_record.mx", "data"),
+					resource.TestCheckResourceAttr("data.scaleway_domain_records.mx", "records.0.priority", "10"),
+				),
+			},
+		},
+	})
+}
@@ -44,7 +44,7 @@ func dataSourceScalewayFlexibleIP() *schema.Resource {
 func dataSourceScalewayFlexibleIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ipID, ipIDExists := d.GetOk("flexible_ip_id")
@@ -53,9 +53,9 @@ func dataSourceScalewayFlexibleIPRead(ctx context.Context, d *schema.ResourceDat
 		res, err := fipAPI.ListFlexibleIPs(&flexibleip.ListFlexibleIPsRequest{
 			Zone:      zone,
 			ProjectID: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		for _, ip := range res.FlexibleIPs {
@@ -75,7 +75,7 @@ func dataSourceScalewayFlexibleIPRead(ctx context.Context, d *schema.ResourceDat
 	d.SetId(zoneID)
 	err = d.Set("flexible_ip_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayFlexibleIPRead(ctx, d, meta)
@@ -125,7 +125,7 @@ func dataSourceScalewayFlexibleIPs() *schema.Resource {
 func dataSourceScalewayFlexibleIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := fipAPI.ListFlexibleIPs(&flexibleip.ListFlexibleIPsRequest{
@@ -133,9 +133,9 @@ func dataSourceScalewayFlexibleIPsRead(ctx context.Context, d *schema.ResourceDa
 		ServerIDs: expandServerIDs(d.Get("server_ids")),
 		ProjectID: expandStringPtr(d.Get("project_id")),
 		Tags:      expandStrings(d.Get("tags")),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	fips := []interface{}(nil)
@@ -153,7 +153,7 @@ func dataSourceScalewayFlexibleIPsRead(ctx context.Context, d *schema.ResourceDa
 		rawFip["status"] = fip.Status
 		ip, err := flattenIPNet(fip.IPAddress)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		rawFip["ip_address"] = ip
 		if fip.MacAddress != nil {
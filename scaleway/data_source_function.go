@@ -32,7 +32,7 @@ func dataSourceScalewayFunction() *schema.Resource {
 func dataSourceScalewayFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	functionID, ok := d.GetOk("function_id")
@@ -41,15 +41,15 @@ func dataSourceScalewayFunctionRead(ctx context.Context, d *schema.ResourceData,
 			Region:      region,
 			NamespaceID: expandID(d.Get("namespace_id").(string)),
 			Name:        expandStringPtr(d.Get("name")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Functions) == 0 {
-			return diag.FromErr(fmt.Errorf("no functions found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no functions found with the name %s", d.Get("name")))
 		}
 		if len(res.Functions) > 1 {
-			return diag.FromErr(fmt.Errorf("%d functions found with the same name %s", len(res.Functions), d.Get("name")))
+			return diagFromErr(fmt.Errorf("%d functions found with the same name %s", len(res.Functions), d.Get("name")))
 		}
 		functionID = res.Functions[0].ID
 	}
@@ -87,6 +87,17 @@ func fixDatasourceSchemaFlags(schema map[string]*schema.Schema, required bool, k
 	}
 }
 
+// datasourceProjectIDSchema returns a schema for a project_id used to filter a data source lookup.
+func datasourceProjectIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The ID of the project the resource is associated to",
+		ValidateFunc: validationUUID(),
+	}
+}
+
 func addOptionalFieldsToSchema(schema map[string]*schema.Schema, keys ...string) {
 	fixDatasourceSchemaFlags(schema, false, keys...)
 }
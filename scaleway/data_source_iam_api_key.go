@@ -0,0 +1,87 @@
+package scaleway
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayIamAPIKey() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayIamAPIKey().Schema)
+
+	delete(dsSchema, "secret_key")
+	delete(dsSchema, "rotate_when_expired")
+	delete(dsSchema, "keepers")
+
+	fixDatasourceSchemaFlags(dsSchema, true, "access_key")
+
+	dsSchema["bearer_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The type of the bearer of the API key, application or user",
+	}
+	dsSchema["bearer_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the application or the email of the user bearing the API key",
+	}
+	dsSchema["expired"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the API key is expired",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayIamAPIKeyRead,
+		Schema:      dsSchema,
+	}
+}
+
+func dataSourceScalewayIamAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accessKey := d.Get("access_key").(string)
+	d.SetId(accessKey)
+
+	diags := resourceScalewayIamAPIKeyRead(ctx, d, meta)
+	if diags != nil {
+		return append(diags, diag.Errorf("failed to read iam api key state")...)
+	}
+
+	if d.Id() == "" {
+		return diag.Errorf("iam api key (%s) not found", accessKey)
+	}
+
+	api := iamAPI(meta)
+	if applicationID, ok := d.GetOk("application_id"); ok {
+		application, err := api.GetApplication(&iam.GetApplicationRequest{
+			ApplicationID: applicationID.(string),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diagFromErr(err)
+		}
+		_ = d.Set("bearer_type", "application")
+		_ = d.Set("bearer_name", application.Name)
+	}
+	if userID, ok := d.GetOk("user_id"); ok {
+		user, err := api.GetUser(&iam.GetUserRequest{
+			UserID: userID.(string),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diagFromErr(err)
+		}
+		_ = d.Set("bearer_type", "user")
+		_ = d.Set("bearer_name", user.Email)
+	}
+
+	expired := false
+	if expiresAt := expandTimePtr(d.Get("expires_at")); expiresAt != nil {
+		expired = expiresAt.Before(time.Now())
+	}
+	_ = d.Set("expired", expired)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccScalewayDataSourceIamAPIKey_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayIamAPIKeyDestroy(tt),
+			testAccCheckScalewayIamApplicationDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_iam_application" "main" {
+						name = "tf_tests_data_source_api_key"
+					}
+
+					resource "scaleway_iam_api_key" "main" {
+						application_id = scaleway_iam_application.main.id
+						description    = "tf_tests_data_source_api_key"
+					}
+
+					data "scaleway_iam_api_key" "main" {
+						access_key = scaleway_iam_api_key.main.access_key
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_api_key.main", "application_id", "scaleway_iam_application.main", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_api_key.main", "description", "tf_tests_data_source_api_key"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_api_key.main", "bearer_type", "application"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_api_key.main", "bearer_name", "tf_tests_data_source_api_key"),
+					resource.TestCheckResourceAttr("data.scaleway_iam_api_key.main", "expired", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceScalewayIamAPIKeyBearer(t *testing.T) {
+	expired := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/iam/v1alpha1/api-keys/SCWAPPLICATION0000":
+			_, _ = w.Write([]byte(`{"access_key":"SCWAPPLICATION0000","application_id":"11111111-1111-1111-1111-111111111111"}`))
+		case "/iam/v1alpha1/api-keys/SCWUSER00000000000":
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"access_key":"SCWUSER00000000000","user_id":"22222222-2222-2222-2222-222222222222","expires_at":%q}`, expired)))
+		case "/iam/v1alpha1/applications/11111111-1111-1111-1111-111111111111":
+			_, _ = w.Write([]byte(`{"id":"11111111-1111-1111-1111-111111111111","name":"ci"}`))
+		case "/iam/v1alpha1/users/22222222-2222-2222-2222-222222222222":
+			_, _ = w.Write([]byte(`{"id":"22222222-2222-2222-2222-222222222222","email":"jane@example.com"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"resource is not found","resource":"api_key","type":"not_found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL))
+	require.NoError(t, err)
+	meta := &Meta{scwClient: client}
+
+	tests := []struct {
+		name               string
+		accessKey          string
+		expectedBearerType string
+		expectedBearerName string
+		expectedExpired    bool
+		err                string
+	}{
+		{name: "application", accessKey: "SCWAPPLICATION0000", expectedBearerType: "application", expectedBearerName: "ci"},
+		{name: "expired user", accessKey: "SCWUSER00000000000", expectedBearerType: "user", expectedBearerName: "jane@example.com", expectedExpired: true},
+		{name: "not found", accessKey: "SCWUNKNOWN00000000", err: "iam api key (SCWUNKNOWN00000000) not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceScalewayIamAPIKey().Schema, map[string]interface{}{
+				"access_key": tt.accessKey,
+			})
+
+			diags := dataSourceScalewayIamAPIKeyRead(context.Background(), d, meta)
+			if tt.err != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, tt.err, diags[0].Summary)
+				return
+			}
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.expectedBearerType, d.Get("bearer_type"))
+			assert.Equal(t, tt.expectedBearerName, d.Get("bearer_name"))
+			assert.Equal(t, tt.expectedExpired, d.Get("expired"))
+		})
+	}
+}
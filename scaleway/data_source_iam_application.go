@@ -44,9 +44,9 @@ func dataSourceScalewayIamApplicationRead(ctx context.Context, d *schema.Resourc
 		res, err := api.ListApplications(&iam.ListApplicationsRequest{
 			OrganizationID: getOrganizationID(meta, d),
 			Name:           expandStringPtr(d.Get("name")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		for _, app := range res.Applications {
@@ -65,7 +65,7 @@ func dataSourceScalewayIamApplicationRead(ctx context.Context, d *schema.Resourc
 	d.SetId(appID.(string))
 	err := d.Set("application_id", appID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayIamApplicationRead(ctx, d, meta)
@@ -45,9 +45,9 @@ func dataSourceScalewayIamGroupRead(ctx context.Context, d *schema.ResourceData,
 			Name:           expandStringPtr(d.Get("name")),
 		}
 
-		res, err := api.ListGroups(req, scw.WithContext(ctx))
+		res, err := api.ListGroups(req, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		for _, group := range res.Groups {
@@ -66,7 +66,7 @@ func dataSourceScalewayIamGroupRead(ctx context.Context, d *schema.ResourceData,
 	d.SetId(groupID.(string))
 	err := d.Set("group_id", groupID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayIamGroupRead(ctx, d, meta)
@@ -0,0 +1,97 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayIamQuotas() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayIamQuotasRead,
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Quotas with these names are listed.",
+			},
+			"organization_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The organization ID the quotas are listed from",
+				ValidateFunc: validationUUID(),
+			},
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"limit": {
+							Computed: true,
+							Type:     schema.TypeInt,
+						},
+						"unlimited": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceScalewayIamQuotasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api := iamAPI(meta)
+
+	orgID := getOrganizationID(meta, d)
+	if orgID == nil {
+		// required not in schema as we could use default
+		return diag.Errorf("organization_id is required")
+	}
+
+	res, err := api.ListQuota(&iam.ListQuotaRequest{
+		OrganizationID: *orgID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	names := make(map[string]bool)
+	for _, name := range expandStrings(d.Get("names")) {
+		names[name] = true
+	}
+
+	quotas := []interface{}(nil)
+	for _, quotum := range res.Quota {
+		if len(names) > 0 && !names[quotum.Name] {
+			continue
+		}
+
+		rawQuotum := make(map[string]interface{})
+		rawQuotum["name"] = quotum.Name
+		if quotum.Limit != nil {
+			rawQuotum["limit"] = int(*quotum.Limit)
+		}
+		rawQuotum["unlimited"] = quotum.Unlimited != nil && *quotum.Unlimited
+
+		quotas = append(quotas, rawQuotum)
+	}
+
+	d.SetId(*orgID)
+	_ = d.Set("organization_id", *orgID)
+	_ = d.Set("quotas", quotas)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceIamQuotas_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_iam_quotas" "all" {
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+
+					data "scaleway_iam_quotas" "by_names" {
+					  names = [data.scaleway_iam_quotas.all.quotas.0.name]
+					  organization_id = "105bdce1-64c0-48ab-899d-868455867ecf"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scaleway_iam_quotas.all", "quotas.0.name"),
+					resource.TestCheckResourceAttrSet("data.scaleway_iam_quotas.all", "quotas.1.name"),
+
+					resource.TestCheckResourceAttr("data.scaleway_iam_quotas.by_names", "quotas.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_quotas.by_names", "quotas.0.name", "data.scaleway_iam_quotas.all", "quotas.0.name"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_quotas.by_names", "quotas.0.limit", "data.scaleway_iam_quotas.all", "quotas.0.limit"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iam_quotas.by_names", "quotas.0.unlimited", "data.scaleway_iam_quotas.all", "quotas.0.unlimited"),
+				),
+			},
+		},
+	})
+}
@@ -14,6 +14,7 @@ func dataSourceScalewayIamSSHKey() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayIamSSKKey().Schema)
 	addOptionalFieldsToSchema(dsSchema, "name")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"ssh_key_id"}
 	dsSchema["ssh_key_id"] = &schema.Schema{
@@ -37,20 +38,20 @@ func dataSourceScalewayIamSSHKeyRead(ctx context.Context, d *schema.ResourceData
 		res, err := iamAPI.ListSSHKeys(&iam.ListSSHKeysRequest{
 			Name:      expandStringPtr(d.Get("name")),
 			ProjectID: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, sshKey := range res.SSHKeys {
 			if sshKey.Name == d.Get("name").(string) {
 				if sshKeyID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 SSH Key found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 SSH Key found with the same name %s", d.Get("name")))
 				}
 				sshKeyID = sshKey.ID
 			}
 		}
 		if sshKeyID == "" {
-			return diag.FromErr(fmt.Errorf("no SSH Key found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no SSH Key found with the name %s", d.Get("name")))
 		}
 	}
 
@@ -58,7 +59,7 @@ func dataSourceScalewayIamSSHKeyRead(ctx context.Context, d *schema.ResourceData
 
 	err := d.Set("ssh_key_id", sshKeyID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayIamSSHKeyRead(ctx, d, meta)
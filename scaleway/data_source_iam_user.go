@@ -49,7 +49,7 @@ func dataSourceScalewayIamUserRead(ctx context.Context, d *schema.ResourceData,
 			UserID: userID.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		email = res.Email
 		organizationID = res.OrganizationID
@@ -58,10 +58,10 @@ func dataSourceScalewayIamUserRead(ctx context.Context, d *schema.ResourceData,
 			OrganizationID: getOrganizationID(meta, d),
 		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Users) == 0 {
-			return diag.FromErr(fmt.Errorf("no user found with the email address %s", d.Get("email")))
+			return diagFromErr(fmt.Errorf("no user found with the email address %s", d.Get("email")))
 		}
 		for _, user := range res.Users {
 			if user.Email == d.Get("email").(string) {
@@ -79,7 +79,7 @@ func dataSourceScalewayIamUserRead(ctx context.Context, d *schema.ResourceData,
 	d.SetId(userID.(string))
 	err := d.Set("user_id", userID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("user_id", userID)
@@ -0,0 +1,108 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceBootscripts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceBootscriptsRead,
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Bootscripts with a title like it are listed.",
+			},
+			"arch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Bootscripts with this architecture are listed.",
+			},
+			"bootscripts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"title": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"arch": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"default": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"public": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"kernel": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"initrd": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"bootcmdargs": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"zone": zoneSchema(),
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayInstanceBootscriptsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	res, err := instanceAPI.ListBootscripts(&instance.ListBootscriptsRequest{
+		Zone:  zone,
+		Title: expandStringPtr(d.Get("title")),
+		Arch:  expandStringPtr(d.Get("arch")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	bootscripts := []interface{}(nil)
+	for _, bootscript := range res.Bootscripts {
+		bootscripts = append(bootscripts, map[string]interface{}{
+			// Bootscripts are referenced by their UUID in servers
+			"id":          bootscript.ID,
+			"title":       bootscript.Title,
+			"arch":        bootscript.Arch.String(),
+			"default":     bootscript.Default,
+			"public":      bootscript.Public,
+			"kernel":      bootscript.Kernel,
+			"initrd":      bootscript.Initrd,
+			"bootcmdargs": bootscript.Bootcmdargs,
+			"zone":        zone.String(),
+		})
+	}
+
+	// Listings with different filters must not share the same ID
+	d.SetId(fmt.Sprintf("%s/%s/%s", zone, d.Get("title").(string), d.Get("arch").(string)))
+	_ = d.Set("bootscripts", bootscripts)
+
+	return nil
+}
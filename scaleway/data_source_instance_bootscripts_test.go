@@ -0,0 +1,38 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceInstanceBootscripts_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_instance_bootscripts" "all" {
+						zone = "fr-par-1"
+					}
+
+					data "scaleway_instance_bootscripts" "x86_64" {
+						arch = "x86_64"
+						zone = "fr-par-1"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_instance_bootscripts.all", "id", "fr-par-1//"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_bootscripts.x86_64", "id", "fr-par-1//x86_64"),
+					resource.TestCheckResourceAttrSet("data.scaleway_instance_bootscripts.x86_64", "bootscripts.0.id"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_bootscripts.x86_64", "bootscripts.0.arch", "x86_64"),
+					resource.TestCheckResourceAttrSet("data.scaleway_instance_bootscripts.x86_64", "bootscripts.0.title"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_bootscripts.x86_64", "bootscripts.0.zone", "fr-par-1"),
+				),
+			},
+		},
+	})
+}
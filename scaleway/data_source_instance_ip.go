@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,15 +20,18 @@ func dataSourceScalewayInstanceIP() *schema.Resource {
 		Optional:      true,
 		Description:   "The ID of the IP address",
 		ValidateFunc:  validationUUIDorUUIDWithLocality(),
-		ConflictsWith: []string{"address"},
+		ConflictsWith: []string{"address", "reverse"},
 	}
 	dsSchema["address"] = &schema.Schema{
 		Type:          schema.TypeString,
 		Optional:      true,
 		Description:   "The IP address",
-		ConflictsWith: []string{"id"},
+		ConflictsWith: []string{"id", "reverse"},
 		ValidateFunc:  validation.IsIPv4Address,
 	}
+	addOptionalFieldsToSchema(dsSchema, "reverse")
+	dsSchema["reverse"].ConflictsWith = []string{"id", "address"}
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	return &schema.Resource{
 		ReadContext: dataSourceScalewayInstanceIPRead,
@@ -39,12 +43,35 @@ func dataSourceScalewayInstanceIP() *schema.Resource {
 func dataSourceScalewayInstanceIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	id, ok := d.GetOk("id")
+	reverse, reverseOk := d.GetOk("reverse")
 	var ID string
-	if !ok {
+	switch {
+	case ok:
+		_, ID, _ = parseLocalizedID(id.(string))
+	case reverseOk:
+		res, err := instanceAPI.ListIPs(&instance.ListIPsRequest{
+			Zone:    zone,
+			Project: expandStringPtr(d.Get("project_id")),
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return diagFromErr(err)
+		}
+		for _, ip := range res.IPs {
+			if ip.Reverse != nil && reverseDNSEquals(*ip.Reverse, reverse.(string)) {
+				if ID != "" {
+					return diagFromErr(fmt.Errorf("more than 1 ip found with the reverse %s", reverse))
+				}
+				ID = ip.ID
+			}
+		}
+		if ID == "" {
+			return diagFromErr(fmt.Errorf("no ip found with the reverse %s", reverse))
+		}
+	default:
 		res, err := instanceAPI.GetIP(&instance.GetIPRequest{
 			IP:   d.Get("address").(string),
 			Zone: zone,
@@ -55,11 +82,9 @@ func dataSourceScalewayInstanceIPRead(ctx context.Context, d *schema.ResourceDat
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		ID = res.IP.ID
-	} else {
-		_, ID, _ = parseLocalizedID(id.(string))
 	}
 	d.SetId(newZonedIDString(zone, ID))
 
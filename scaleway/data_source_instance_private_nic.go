@@ -36,7 +36,7 @@ func dataSourceScalewayInstancePrivateNIC() *schema.Resource {
 func dataSourceScalewayInstancePrivateNICRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	serverID := expandID(d.Get("server_id"))
@@ -48,14 +48,14 @@ func dataSourceScalewayInstancePrivateNICRead(ctx context.Context, d *schema.Res
 			Zone:     zone,
 			ServerID: serverID,
 			Tags:     expandStrings(d.Get("tags")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to list instance private_nic: %w", err))
+			return diagFromErr(fmt.Errorf("failed to list instance private_nic: %w", err))
 		}
 
 		privateNic, err := privateNICWithFilters(resp.PrivateNics, d)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		privateNICID = privateNic.ID
@@ -71,7 +71,7 @@ func dataSourceScalewayInstancePrivateNICRead(ctx context.Context, d *schema.Res
 	d.SetId(zonedID)
 	err = d.Set("private_nic_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayInstancePrivateNICRead(ctx, d, meta)
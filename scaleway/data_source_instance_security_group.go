@@ -16,6 +16,7 @@ func dataSourceScalewayInstanceSecurityGroup() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "zone")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"security_group_id"}
 	dsSchema["security_group_id"] = &schema.Schema{
@@ -36,7 +37,7 @@ func dataSourceScalewayInstanceSecurityGroup() *schema.Resource {
 func dataSourceScalewayInstanceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	securityGroupID, ok := d.GetOk("security_group_id")
@@ -47,18 +48,18 @@ func dataSourceScalewayInstanceSecurityGroupRead(ctx context.Context, d *schema.
 			Project: expandStringPtr(d.Get("project_id")),
 		}, scw.WithAllPages(), scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, sg := range res.SecurityGroups {
 			if sg.Name == d.Get("name").(string) {
 				if securityGroupID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 security group found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 security group found with the same name %s", d.Get("name")))
 				}
 				securityGroupID = sg.ID
 			}
 		}
 		if securityGroupID == "" {
-			return diag.FromErr(fmt.Errorf("no security group found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no security group found with the name %s", d.Get("name")))
 		}
 	}
 
@@ -0,0 +1,133 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceSecurityGroupCheck() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceSecurityGroupCheckRead,
+		Schema: map[string]*schema.Schema{
+			// Input
+			"security_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the security group to evaluate",
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.SecurityGroupRuleDirectionInbound.String(),
+					instance.SecurityGroupRuleDirectionOutbound.String(),
+				}, false),
+				Description: "The direction of the traffic",
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.SecurityGroupRuleProtocolTCP.String(),
+					instance.SecurityGroupRuleProtocolUDP.String(),
+					instance.SecurityGroupRuleProtocolICMP.String(),
+				}, false),
+				Description: "The protocol of the traffic",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "The destination port of the traffic, required unless the protocol is ICMP",
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "The remote IP of the traffic: its source when inbound, its destination when outbound",
+			},
+			"zone": zoneSchema(),
+
+			// Computed
+			"action": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The action applied to the traffic",
+			},
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the traffic is accepted",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the rule matching the traffic, empty when the default policy applies",
+			},
+		},
+	}
+}
+
+func dataSourceScalewayInstanceSecurityGroupCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	protocol := instance.SecurityGroupRuleProtocol(d.Get("protocol").(string))
+	if _, ok := d.GetOk("port"); !ok && protocol != instance.SecurityGroupRuleProtocolICMP {
+		return diagFromErr(fmt.Errorf("port is required with protocol %s", protocol))
+	}
+
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	securityGroupID := expandID(d.Get("security_group_id"))
+
+	res, err := instanceAPI.GetSecurityGroup(&instance.GetSecurityGroupRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	rules, err := instanceAPI.ListSecurityGroupRules(&instance.ListSecurityGroupRulesRequest{
+		Zone:            zone,
+		SecurityGroupID: securityGroupID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	direction := instance.SecurityGroupRuleDirection(d.Get("direction").(string))
+	defaultPolicy := res.SecurityGroup.InboundDefaultPolicy
+	if direction == instance.SecurityGroupRuleDirectionOutbound {
+		defaultPolicy = res.SecurityGroup.OutboundDefaultPolicy
+	}
+
+	action, rule := evaluateInstanceSecurityGroupRules(
+		rules.Rules,
+		defaultPolicy,
+		direction,
+		protocol,
+		uint32(d.Get("port").(int)),
+		net.ParseIP(d.Get("ip").(string)),
+	)
+
+	d.SetId(newZonedIDString(zone, securityGroupID))
+	_ = d.Set("zone", zone.String())
+	_ = d.Set("action", action.String())
+	_ = d.Set("allowed", action == instance.SecurityGroupRuleActionAccept)
+	if rule != nil {
+		_ = d.Set("rule_id", newZonedIDString(zone, rule.ID))
+	} else {
+		_ = d.Set("rule_id", "")
+	}
+
+	return nil
+}
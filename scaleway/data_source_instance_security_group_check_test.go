@@ -0,0 +1,73 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceScalewayInstanceSecurityGroupCheckPort(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/instance/v1/zones/fr-par-1/security_groups/11111111-1111-1111-1111-111111111111":
+			_, _ = w.Write([]byte(`{"security_group":{"id":"11111111-1111-1111-1111-111111111111","inbound_default_policy":"drop","outbound_default_policy":"accept"}}`))
+		case "/instance/v1/zones/fr-par-1/security_groups/11111111-1111-1111-1111-111111111111/rules":
+			_, _ = w.Write([]byte(`{"rules":[
+				{"id":"icmp","direction":"inbound","protocol":"ICMP","action":"accept","ip_range":"0.0.0.0/0","position":1},
+				{"id":"ssh","direction":"inbound","protocol":"TCP","action":"accept","ip_range":"0.0.0.0/0","dest_port_from":22,"position":2}
+			],"total_count":2}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL), scw.WithDefaultZone(scw.ZoneFrPar1))
+	require.NoError(t, err)
+	meta := &Meta{scwClient: client}
+
+	tests := []struct {
+		name           string
+		config         map[string]interface{}
+		expectedRuleID string
+		err            string
+	}{
+		{name: "tcp without port", config: map[string]interface{}{"protocol": "TCP"}, err: "port is required with protocol TCP"},
+		{name: "udp without port", config: map[string]interface{}{"protocol": "UDP"}, err: "port is required with protocol UDP"},
+		{name: "tcp with port", config: map[string]interface{}{"protocol": "TCP", "port": 22}, expectedRuleID: "fr-par-1/ssh"},
+		{name: "icmp without port", config: map[string]interface{}{"protocol": "ICMP"}, expectedRuleID: "fr-par-1/icmp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"security_group_id": "fr-par-1/11111111-1111-1111-1111-111111111111",
+				"direction":         "inbound",
+				"ip":                "51.15.1.1",
+				"zone":              "fr-par-1",
+			}
+			for k, v := range tt.config {
+				raw[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, dataSourceScalewayInstanceSecurityGroupCheck().Schema, raw)
+
+			diags := dataSourceScalewayInstanceSecurityGroupCheckRead(context.Background(), d, meta)
+			if tt.err != "" {
+				require.True(t, diags.HasError())
+				assert.Contains(t, diags[0].Summary, tt.err)
+				return
+			}
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.expectedRuleID, d.Get("rule_id"))
+			assert.Equal(t, true, d.Get("allowed"))
+		})
+	}
+}
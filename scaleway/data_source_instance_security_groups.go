@@ -0,0 +1,178 @@
+package scaleway
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceSecurityGroups() *schema.Resource {
+	ruleSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Computed: true,
+				Type:     schema.TypeString,
+			},
+			"protocol": {
+				Computed: true,
+				Type:     schema.TypeString,
+			},
+			"port_range": {
+				Computed: true,
+				Type:     schema.TypeString,
+			},
+			"ip_range": {
+				Computed: true,
+				Type:     schema.TypeString,
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceSecurityGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Security groups with a name like it are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Security groups with these exact tags are listed.",
+			},
+			"security_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"description": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"stateful": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"project_default": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"inbound_default_policy": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"outbound_default_policy": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"inbound_rule": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem:     ruleSchema,
+						},
+						"outbound_rule": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem:     ruleSchema,
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"zone":            zoneSchema(),
+						"organization_id": organizationIDSchema(),
+						"project_id":      projectIDSchema(),
+					},
+				},
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayInstanceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	res, err := instanceAPI.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+		Zone:    zone,
+		Name:    expandStringPtr(d.Get("name")),
+		Project: expandStringPtr(d.Get("project_id")),
+		Tags:    expandStrings(d.Get("tags")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	securityGroups := []interface{}(nil)
+	for _, securityGroup := range res.SecurityGroups {
+		resRules, err := instanceAPI.ListSecurityGroupRules(&instance.ListSecurityGroupRulesRequest{
+			Zone:            zone,
+			SecurityGroupID: securityGroup.ID,
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return diagFromErr(err)
+		}
+		sort.Slice(resRules.Rules, func(i, j int) bool {
+			return resRules.Rules[i].Position < resRules.Rules[j].Position
+		})
+
+		rules := map[instance.SecurityGroupRuleDirection][]interface{}{
+			instance.SecurityGroupRuleDirectionInbound:  {},
+			instance.SecurityGroupRuleDirectionOutbound: {},
+		}
+		for _, rule := range resRules.Rules {
+			rawRule, err := securityGroupRuleFlatten(rule)
+			if err != nil {
+				return diagFromErr(err)
+			}
+			rules[rule.Direction] = append(rules[rule.Direction], rawRule)
+		}
+
+		rawSecurityGroup := make(map[string]interface{})
+		rawSecurityGroup["id"] = newZonedIDString(zone, securityGroup.ID)
+		rawSecurityGroup["name"] = securityGroup.Name
+		rawSecurityGroup["description"] = securityGroup.Description
+		rawSecurityGroup["stateful"] = securityGroup.Stateful
+		rawSecurityGroup["project_default"] = securityGroup.ProjectDefault
+		rawSecurityGroup["inbound_default_policy"] = securityGroup.InboundDefaultPolicy.String()
+		rawSecurityGroup["outbound_default_policy"] = securityGroup.OutboundDefaultPolicy.String()
+		rawSecurityGroup["inbound_rule"] = rules[instance.SecurityGroupRuleDirectionInbound]
+		rawSecurityGroup["outbound_rule"] = rules[instance.SecurityGroupRuleDirectionOutbound]
+		if len(securityGroup.Tags) > 0 {
+			rawSecurityGroup["tags"] = securityGroup.Tags
+		}
+		rawSecurityGroup["zone"] = string(zone)
+		rawSecurityGroup["organization_id"] = securityGroup.Organization
+		rawSecurityGroup["project_id"] = securityGroup.Project
+
+		securityGroups = append(securityGroups, rawSecurityGroup)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("security_groups", securityGroups)
+
+	return nil
+}
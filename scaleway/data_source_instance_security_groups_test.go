@@ -0,0 +1,85 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceInstanceSecurityGroups_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayInstanceSecurityGroupDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_security_group" "sg1" {
+						name                   = "tf-sg-datasource0"
+						inbound_default_policy = "drop"
+						tags                   = [ "terraform-test", "data_scaleway_instance_security_groups", "basic" ]
+
+						inbound_rule {
+							action   = "accept"
+							port     = 22
+							ip_range = "0.0.0.0/0"
+						}
+					}
+
+					resource "scaleway_instance_security_group" "sg2" {
+						name = "tf-sg-datasource1"
+						tags = [ "terraform-test", "data_scaleway_instance_security_groups", "basic" ]
+					}`,
+			},
+			{
+				Config: `
+					resource "scaleway_instance_security_group" "sg1" {
+						name                   = "tf-sg-datasource0"
+						inbound_default_policy = "drop"
+						tags                   = [ "terraform-test", "data_scaleway_instance_security_groups", "basic" ]
+
+						inbound_rule {
+							action   = "accept"
+							port     = 22
+							ip_range = "0.0.0.0/0"
+						}
+					}
+
+					resource "scaleway_instance_security_group" "sg2" {
+						name = "tf-sg-datasource1"
+						tags = [ "terraform-test", "data_scaleway_instance_security_groups", "basic" ]
+					}
+
+					data "scaleway_instance_security_groups" "by_name" {
+						name = "tf-sg-datasource0"
+					}
+
+					data "scaleway_instance_security_groups" "by_tag" {
+						tags = [ "data_scaleway_instance_security_groups", "terraform-test" ]
+					}
+
+					data "scaleway_instance_security_groups" "by_name_other_zone" {
+						name = "tf-sg-datasource"
+						zone = "fr-par-2"
+					}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_name", "security_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_security_groups.by_name", "security_groups.0.id", "scaleway_instance_security_group.sg1", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_name", "security_groups.0.name", "tf-sg-datasource0"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_name", "security_groups.0.inbound_default_policy", "drop"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_name", "security_groups.0.inbound_rule.#", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_name", "security_groups.0.inbound_rule.0.action", "accept"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_name", "security_groups.0.inbound_rule.0.ip_range", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_name", "security_groups.0.tags.#", "3"),
+
+					resource.TestCheckResourceAttr("data.scaleway_instance_security_groups.by_tag", "security_groups.#", "2"),
+
+					resource.TestCheckNoResourceAttr("data.scaleway_instance_security_groups.by_name_other_zone", "security_groups.0.id"),
+				),
+			},
+		},
+	})
+}
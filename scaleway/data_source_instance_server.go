@@ -15,15 +15,23 @@ func dataSourceScalewayInstanceServer() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayInstanceServer().Schema)
 
 	// Set 'Optional' schema elements
-	addOptionalFieldsToSchema(dsSchema, "name", "zone")
+	addOptionalFieldsToSchema(dsSchema, "name", "zone", "tags")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"server_id"}
+	dsSchema["tags"].ConflictsWith = []string{"server_id"}
 	dsSchema["server_id"] = &schema.Schema{
 		Type:          schema.TypeString,
 		Optional:      true,
 		Description:   "The ID of the server",
 		ValidateFunc:  validationUUIDorUUIDWithLocality(),
-		ConflictsWith: []string{"name"},
+		ConflictsWith: []string{"name", "tags"},
+	}
+	dsSchema["most_recent"] = &schema.Schema{
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Description:   "Select the most recently created server if several servers match the name and tags",
+		ConflictsWith: []string{"server_id"},
 	}
 
 	return &schema.Resource{
@@ -36,30 +44,43 @@ func dataSourceScalewayInstanceServer() *schema.Resource {
 func dataSourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	serverID, ok := d.GetOk("server_id")
 	if !ok {
+		name := d.Get("name").(string)
+		tags := expandStrings(d.Get("tags"))
+		if name == "" && len(tags) == 0 {
+			return diagFromErr(fmt.Errorf("one of server_id, name or tags must be set"))
+		}
+
 		res, err := instanceAPI.ListServers(&instance.ListServersRequest{
 			Zone:    zone,
-			Name:    expandStringPtr(d.Get("name")),
+			Name:    expandStringPtr(name),
+			Tags:    tags,
 			Project: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
+
+		var matchingServer *instance.Server
 		for _, server := range res.Servers {
-			if server.Name == d.Get("name").(string) {
-				if serverID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 server found with the same name %s", d.Get("name")))
-				}
-				serverID = server.ID
+			if name != "" && server.Name != name {
+				continue
+			}
+			if matchingServer != nil && !d.Get("most_recent").(bool) {
+				return diagFromErr(fmt.Errorf("more than 1 server found with the name %q and tags %v, set most_recent to select the newest one", name, tags))
+			}
+			if matchingServer == nil || isInstanceServerMoreRecent(server, matchingServer) {
+				matchingServer = server
 			}
 		}
-		if serverID == "" {
-			return diag.FromErr(fmt.Errorf("no server found with the name %s", d.Get("name")))
+		if matchingServer == nil {
+			return diagFromErr(fmt.Errorf("no server found with the name %q and tags %v", name, tags))
 		}
+		serverID = matchingServer.ID
 	}
 
 	zonedID := datasourceNewZonedID(serverID, zone)
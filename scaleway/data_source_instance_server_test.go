@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -50,6 +51,80 @@ func TestAccScalewayDataSourceInstanceServer_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.scaleway_instance_server.stg", "name", serverName),
 				),
 			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_server" "main" {
+						name 	= "%s"
+						image = "ubuntu_focal"
+						type  = "DEV1-S"
+						state = "stopped"
+						tags  = [ "terraform-test", "data_scaleway_instance_server", "basic" ]
+					}
+
+					data "scaleway_instance_server" "by_tags" {
+						tags = [ "data_scaleway_instance_server", "basic" ]
+						depends_on = [scaleway_instance_server.main]
+					}`, serverName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayInstanceServerExists(tt, "data.scaleway_instance_server.by_tags"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_server.by_tags", "id", "scaleway_instance_server.main", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_server" "main" {
+						name 	= "%s"
+						image = "ubuntu_focal"
+						type  = "DEV1-S"
+						state = "stopped"
+						tags  = [ "terraform-test", "data_scaleway_instance_server", "basic" ]
+					}
+
+					resource "scaleway_instance_server" "newer" {
+						name 	= "%s"
+						image = "ubuntu_focal"
+						type  = "DEV1-S"
+						state = "stopped"
+						tags  = [ "terraform-test", "data_scaleway_instance_server", "basic" ]
+						depends_on = [scaleway_instance_server.main]
+					}
+
+					data "scaleway_instance_server" "most_recent" {
+						name = "%s"
+						tags = [ "data_scaleway_instance_server", "basic" ]
+						most_recent = true
+						depends_on = [scaleway_instance_server.newer]
+					}`, serverName, serverName, serverName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_server.most_recent", "id", "scaleway_instance_server.newer", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_instance_server" "main" {
+						name 	= "%s"
+						image = "ubuntu_focal"
+						type  = "DEV1-S"
+						state = "stopped"
+						tags  = [ "terraform-test", "data_scaleway_instance_server", "basic" ]
+					}
+
+					resource "scaleway_instance_server" "newer" {
+						name 	= "%s"
+						image = "ubuntu_focal"
+						type  = "DEV1-S"
+						state = "stopped"
+						tags  = [ "terraform-test", "data_scaleway_instance_server", "basic" ]
+						depends_on = [scaleway_instance_server.main]
+					}
+
+					data "scaleway_instance_server" "duplicate" {
+						name = "%s"
+						tags = [ "data_scaleway_instance_server", "basic" ]
+						depends_on = [scaleway_instance_server.newer]
+					}`, serverName, serverName, serverName),
+				ExpectError: regexp.MustCompile("more than 1 server found"),
+			},
 		},
 	})
 }
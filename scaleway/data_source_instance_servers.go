@@ -124,16 +124,16 @@ func dataSourceScalewayInstanceServers() *schema.Resource {
 func dataSourceScalewayInstanceServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	res, err := instanceAPI.ListServers(&instance.ListServersRequest{
 		Zone:    zone,
 		Name:    expandStringPtr(d.Get("name")),
 		Project: expandStringPtr(d.Get("project_id")),
 		Tags:    expandStrings(d.Get("tags")),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var diags diag.Diagnostics
@@ -150,7 +150,7 @@ func dataSourceScalewayInstanceServersRead(ctx context.Context, d *schema.Resour
 		}
 		state, err := serverStateFlatten(server.State)
 		if err != nil {
-			diags = append(diags, diag.FromErr(err)...)
+			diags = append(diags, diagFromErr(err)...)
 			continue
 		}
 		rawServer["state"] = state
@@ -179,7 +179,7 @@ func dataSourceScalewayInstanceServersRead(ctx context.Context, d *schema.Resour
 			rawServer["ipv6_gateway"] = server.IPv6.Gateway.String()
 			prefixLength, err := strconv.Atoi(server.IPv6.Netmask)
 			if err != nil {
-				diags = append(diags, diag.FromErr(fmt.Errorf("failed to read ipv6 netmask: %w", err))...)
+				diags = append(diags, diagFromErr(fmt.Errorf("failed to read ipv6 netmask: %w", err))...)
 				continue
 			}
 
@@ -16,6 +16,7 @@ func dataSourceScalewayInstanceSnapshot() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "zone")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["snapshot_id"] = &schema.Schema{
 		Type:          schema.TypeString,
@@ -35,7 +36,7 @@ func dataSourceScalewayInstanceSnapshot() *schema.Resource {
 func dataSourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	snapshotID, ok := d.GetOk("snapshot_id")
@@ -44,20 +45,20 @@ func dataSourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.Resou
 			Zone:    zone,
 			Name:    expandStringPtr(d.Get("name")),
 			Project: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, snapshot := range res.Snapshots {
 			if snapshot.Name == d.Get("name").(string) {
 				if snapshotID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 snapshot found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 snapshot found with the same name %s", d.Get("name")))
 				}
 				snapshotID = snapshot.ID
 			}
 		}
 		if snapshotID == "" {
-			return diag.FromErr(fmt.Errorf("no snapshot found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no snapshot found with the name %s", d.Get("name")))
 		}
 	}
 
@@ -67,7 +68,7 @@ func dataSourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.Resou
 
 	err = d.Set("snapshot_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	diags := resourceScalewayInstanceSnapshotRead(ctx, d, meta)
 	if len(diags) > 0 {
@@ -0,0 +1,155 @@
+package scaleway
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceSnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceSnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Snapshots with a name like it are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Snapshots with these exact tags are listed.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Snapshots with this volume type are listed.",
+				ValidateFunc: validation.StringInSlice([]string{
+					instance.VolumeVolumeTypeBSSD.String(),
+					instance.VolumeVolumeTypeLSSD.String(),
+					instance.VolumeVolumeTypeUnified.String(),
+				}, false),
+			},
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"size_in_gb": {
+							Computed: true,
+							Type:     schema.TypeInt,
+						},
+						"state": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"base_volume_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"zone":            zoneSchema(),
+						"organization_id": organizationIDSchema(),
+						"project_id":      projectIDSchema(),
+					},
+				},
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayInstanceSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	req := &instance.ListSnapshotsRequest{
+		Zone:    zone,
+		Name:    expandStringPtr(d.Get("name")),
+		Project: expandStringPtr(d.Get("project_id")),
+	}
+	if tags := expandStrings(d.Get("tags")); len(tags) > 0 {
+		req.Tags = scw.StringPtr(strings.Join(tags, ","))
+	}
+
+	res, err := instanceAPI.ListSnapshots(req, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// Most recent snapshots first
+	sort.SliceStable(res.Snapshots, func(i, j int) bool {
+		if res.Snapshots[i].CreationDate == nil || res.Snapshots[j].CreationDate == nil {
+			return res.Snapshots[j].CreationDate == nil && res.Snapshots[i].CreationDate != nil
+		}
+		return res.Snapshots[i].CreationDate.After(*res.Snapshots[j].CreationDate)
+	})
+
+	volumeType, filterVolumeType := d.GetOk("type")
+
+	snapshots := []interface{}(nil)
+	for _, snapshot := range res.Snapshots {
+		if filterVolumeType && snapshot.VolumeType.String() != volumeType.(string) {
+			continue
+		}
+
+		rawSnapshot := make(map[string]interface{})
+		rawSnapshot["id"] = newZonedIDString(zone, snapshot.ID)
+		rawSnapshot["name"] = snapshot.Name
+		rawSnapshot["type"] = snapshot.VolumeType.String()
+		rawSnapshot["size_in_gb"] = int(uint64(snapshot.Size) / gb)
+		rawSnapshot["state"] = snapshot.State.String()
+		if snapshot.BaseVolume != nil {
+			rawSnapshot["base_volume_id"] = newZonedIDString(zone, snapshot.BaseVolume.ID)
+		}
+		rawSnapshot["created_at"] = flattenTime(snapshot.CreationDate)
+		if len(snapshot.Tags) > 0 {
+			rawSnapshot["tags"] = snapshot.Tags
+		}
+		rawSnapshot["zone"] = string(zone)
+		rawSnapshot["organization_id"] = snapshot.Organization
+		rawSnapshot["project_id"] = snapshot.Project
+
+		snapshots = append(snapshots, rawSnapshot)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("snapshots", snapshots)
+
+	return nil
+}
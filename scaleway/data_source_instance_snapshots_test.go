@@ -0,0 +1,86 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceInstanceSnapshots_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayInstanceSnapshotDestroy(tt),
+			testAccCheckScalewayInstanceVolumeDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_volume" "main" {
+						type       = "b_ssd"
+						size_in_gb = 10
+					}
+
+					resource "scaleway_instance_snapshot" "snapshot1" {
+						name      = "tf-snapshot-datasource0"
+						volume_id = scaleway_instance_volume.main.id
+						tags      = [ "terraform-test", "data_scaleway_instance_snapshots", "basic" ]
+					}
+
+					resource "scaleway_instance_snapshot" "snapshot2" {
+						name      = "tf-snapshot-datasource1"
+						volume_id = scaleway_instance_volume.main.id
+						tags      = [ "terraform-test", "data_scaleway_instance_snapshots", "basic" ]
+					}`,
+			},
+			{
+				Config: `
+					resource "scaleway_instance_volume" "main" {
+						type       = "b_ssd"
+						size_in_gb = 10
+					}
+
+					resource "scaleway_instance_snapshot" "snapshot1" {
+						name      = "tf-snapshot-datasource0"
+						volume_id = scaleway_instance_volume.main.id
+						tags      = [ "terraform-test", "data_scaleway_instance_snapshots", "basic" ]
+					}
+
+					resource "scaleway_instance_snapshot" "snapshot2" {
+						name      = "tf-snapshot-datasource1"
+						volume_id = scaleway_instance_volume.main.id
+						tags      = [ "terraform-test", "data_scaleway_instance_snapshots", "basic" ]
+					}
+
+					data "scaleway_instance_snapshots" "by_name" {
+						name = "tf-snapshot-datasource0"
+					}
+
+					data "scaleway_instance_snapshots" "by_tag" {
+						tags = [ "data_scaleway_instance_snapshots", "terraform-test" ]
+					}
+
+					data "scaleway_instance_snapshots" "by_type" {
+						tags = [ "data_scaleway_instance_snapshots", "terraform-test" ]
+						type = "l_ssd"
+					}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_instance_snapshots.by_name", "snapshots.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_snapshots.by_name", "snapshots.0.id", "scaleway_instance_snapshot.snapshot1", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_snapshots.by_name", "snapshots.0.type", "b_ssd"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_snapshots.by_name", "snapshots.0.size_in_gb", "10"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_snapshots.by_name", "snapshots.0.base_volume_id", "scaleway_instance_volume.main", "id"),
+					resource.TestCheckResourceAttrSet("data.scaleway_instance_snapshots.by_name", "snapshots.0.created_at"),
+
+					resource.TestCheckResourceAttr("data.scaleway_instance_snapshots.by_tag", "snapshots.#", "2"),
+
+					resource.TestCheckResourceAttr("data.scaleway_instance_snapshots.by_type", "snapshots.#", "0"),
+				),
+			},
+		},
+	})
+}
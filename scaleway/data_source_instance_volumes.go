@@ -0,0 +1,130 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayInstanceVolumes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayInstanceVolumesRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Volumes with a name like it are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Volumes with these exact tags are listed.",
+			},
+			"attached": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only attached volumes are listed when true, only detached volumes when false.",
+			},
+			"volumes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"size_in_gb": {
+							Computed: true,
+							Type:     schema.TypeInt,
+						},
+						"state": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"server_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"zone":            zoneSchema(),
+						"organization_id": organizationIDSchema(),
+						"project_id":      projectIDSchema(),
+					},
+				},
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayInstanceVolumesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	res, err := instanceAPI.ListVolumes(&instance.ListVolumesRequest{
+		Zone:    zone,
+		Name:    expandStringPtr(d.Get("name")),
+		Project: expandStringPtr(d.Get("project_id")),
+		Tags:    expandStrings(d.Get("tags")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	attached := getBool(d, "attached")
+
+	volumes := []interface{}(nil)
+	for _, volume := range res.Volumes {
+		isAttached := volume.Server != nil && volume.Server.ID != ""
+		if attached != nil && isAttached != attached.(bool) {
+			continue
+		}
+
+		rawVolume := make(map[string]interface{})
+		rawVolume["id"] = newZonedIDString(zone, volume.ID)
+		rawVolume["name"] = volume.Name
+		rawVolume["type"] = volume.VolumeType.String()
+		rawVolume["size_in_gb"] = int(uint64(volume.Size) / gb)
+		rawVolume["state"] = volume.State.String()
+		if isAttached {
+			rawVolume["server_id"] = newZonedIDString(zone, volume.Server.ID)
+		}
+		if len(volume.Tags) > 0 {
+			rawVolume["tags"] = volume.Tags
+		}
+		rawVolume["zone"] = string(zone)
+		rawVolume["organization_id"] = volume.Organization
+		rawVolume["project_id"] = volume.Project
+
+		volumes = append(volumes, rawVolume)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("volumes", volumes)
+
+	return nil
+}
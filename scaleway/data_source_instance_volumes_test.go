@@ -0,0 +1,105 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceInstanceVolumes_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayInstanceServerDestroy(tt),
+			testAccCheckScalewayInstanceVolumeDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_volume" "volume1" {
+						name       = "tf-volume-datasource0"
+						type       = "b_ssd"
+						size_in_gb = 10
+						tags       = [ "terraform-test", "data_scaleway_instance_volumes", "basic" ]
+					}
+
+					resource "scaleway_instance_volume" "volume2" {
+						name       = "tf-volume-datasource1"
+						type       = "b_ssd"
+						size_in_gb = 20
+						tags       = [ "terraform-test", "data_scaleway_instance_volumes", "basic" ]
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_focal"
+						type  = "DEV1-S"
+						state = "stopped"
+
+						additional_volume_ids = [ scaleway_instance_volume.volume2.id ]
+					}`,
+			},
+			{
+				Config: `
+					resource "scaleway_instance_volume" "volume1" {
+						name       = "tf-volume-datasource0"
+						type       = "b_ssd"
+						size_in_gb = 10
+						tags       = [ "terraform-test", "data_scaleway_instance_volumes", "basic" ]
+					}
+
+					resource "scaleway_instance_volume" "volume2" {
+						name       = "tf-volume-datasource1"
+						type       = "b_ssd"
+						size_in_gb = 20
+						tags       = [ "terraform-test", "data_scaleway_instance_volumes", "basic" ]
+					}
+
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_focal"
+						type  = "DEV1-S"
+						state = "stopped"
+
+						additional_volume_ids = [ scaleway_instance_volume.volume2.id ]
+					}
+
+					data "scaleway_instance_volumes" "by_name" {
+						name = "tf-volume-datasource0"
+					}
+
+					data "scaleway_instance_volumes" "by_tag" {
+						tags = [ "data_scaleway_instance_volumes", "terraform-test" ]
+					}
+
+					data "scaleway_instance_volumes" "attached" {
+						tags     = [ "data_scaleway_instance_volumes", "terraform-test" ]
+						attached = true
+					}
+
+					data "scaleway_instance_volumes" "detached" {
+						tags     = [ "data_scaleway_instance_volumes", "terraform-test" ]
+						attached = false
+					}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_instance_volumes.by_name", "volumes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_volumes.by_name", "volumes.0.id", "scaleway_instance_volume.volume1", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_volumes.by_name", "volumes.0.type", "b_ssd"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_volumes.by_name", "volumes.0.size_in_gb", "10"),
+					resource.TestCheckResourceAttr("data.scaleway_instance_volumes.by_name", "volumes.0.tags.#", "3"),
+
+					resource.TestCheckResourceAttr("data.scaleway_instance_volumes.by_tag", "volumes.#", "2"),
+
+					resource.TestCheckResourceAttr("data.scaleway_instance_volumes.attached", "volumes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_volumes.attached", "volumes.0.id", "scaleway_instance_volume.volume2", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_volumes.attached", "volumes.0.server_id", "scaleway_instance_server.main", "id"),
+
+					resource.TestCheckResourceAttr("data.scaleway_instance_volumes.detached", "volumes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_instance_volumes.detached", "volumes.0.id", "scaleway_instance_volume.volume1", "id"),
+				),
+			},
+		},
+	})
+}
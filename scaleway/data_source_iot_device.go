@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/iot/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 func dataSourceScalewayIotDevice() *schema.Resource {
@@ -32,7 +33,7 @@ func dataSourceScalewayIotDevice() *schema.Resource {
 func dataSourceScalewayIotDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := iotAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	deviceID, ok := d.GetOk("device_id")
@@ -41,16 +42,16 @@ func dataSourceScalewayIotDeviceRead(ctx context.Context, d *schema.ResourceData
 		if hubIDExists {
 			_, hubID, err = parseRegionalID(hubID.(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 		res, err := api.ListDevices(&iot.ListDevicesRequest{
 			Region: region,
 			Name:   expandStringPtr(d.Get("name")),
 			HubID:  expandStringPtr(hubID),
-		})
+		}, scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, device := range res.Devices {
 			if device.Name == d.Get("name").(string) {
@@ -69,7 +70,7 @@ func dataSourceScalewayIotDeviceRead(ctx context.Context, d *schema.ResourceData
 	d.SetId(regionalID)
 	err = d.Set("device_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	diags := resourceScalewayIotDeviceRead(ctx, d, meta)
 	if diags != nil {
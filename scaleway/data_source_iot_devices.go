@@ -0,0 +1,145 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/iot/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayIotDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayIotDevicesRead,
+		Schema: map[string]*schema.Schema{
+			"hub_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "Devices attached to this hub are listed.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Devices with this name are listed.",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					iot.DeviceStatusEnabled.String(),
+					iot.DeviceStatusDisabled.String(),
+					iot.DeviceStatusError.String(),
+				}, false),
+				Description: "Devices with this status are listed.",
+			},
+			"device_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of devices found",
+			},
+			"connected_device_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of found devices currently connected to their hub",
+			},
+			"devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"hub_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"status": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"is_connected": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"last_activity_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"updated_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"region": regionSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayIotDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, region, err := iotAPIWithRegion(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var hubID *string
+	if rawHubID, ok := d.GetOk("hub_id"); ok {
+		hubID = scw.StringPtr(expandID(rawHubID))
+	}
+
+	res, err := api.ListDevices(&iot.ListDevicesRequest{
+		Region: region,
+		Name:   expandStringPtr(d.Get("name")),
+		HubID:  hubID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// An empty status filter would be sent as "unknown", filter devices here instead
+	status := d.Get("status").(string)
+
+	devices := []interface{}(nil)
+	connectedDeviceCount := 0
+	for _, device := range res.Devices {
+		if status != "" && device.Status.String() != status {
+			continue
+		}
+		if device.IsConnected {
+			connectedDeviceCount++
+		}
+
+		rawDevice := make(map[string]interface{})
+		rawDevice["id"] = newRegionalIDString(region, device.ID)
+		rawDevice["name"] = device.Name
+		rawDevice["hub_id"] = newRegionalIDString(region, device.HubID)
+		rawDevice["status"] = device.Status.String()
+		rawDevice["is_connected"] = device.IsConnected
+		rawDevice["last_activity_at"] = flattenTime(device.LastActivityAt)
+		rawDevice["created_at"] = flattenTime(device.CreatedAt)
+		rawDevice["updated_at"] = flattenTime(device.UpdatedAt)
+
+		devices = append(devices, rawDevice)
+	}
+
+	d.SetId(region.String())
+	_ = d.Set("devices", devices)
+	_ = d.Set("device_count", len(devices))
+	_ = d.Set("connected_device_count", connectedDeviceCount)
+
+	return nil
+}
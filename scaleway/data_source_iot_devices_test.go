@@ -0,0 +1,122 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccScalewayDataSourceIotDevices_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	devicesConfig := `
+		resource "scaleway_iot_hub" "test" {
+			name         = "test_iot_hub_devices_datasource"
+			product_plan = "plan_shared"
+		}
+
+		resource "scaleway_iot_device" "dev1" {
+			name   = "test_iot_devices_datasource_0"
+			hub_id = scaleway_iot_hub.test.id
+		}
+
+		resource "scaleway_iot_device" "dev2" {
+			name   = "test_iot_devices_datasource_1"
+			hub_id = scaleway_iot_hub.test.id
+		}
+	`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayIotHubDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: devicesConfig,
+			},
+			{
+				Config: devicesConfig + `
+					data "scaleway_iot_devices" "by_hub" {
+						hub_id = scaleway_iot_hub.test.id
+					}
+
+					data "scaleway_iot_devices" "by_name" {
+						hub_id = scaleway_iot_hub.test.id
+						name   = "test_iot_devices_datasource_0"
+					}
+
+					data "scaleway_iot_devices" "by_status" {
+						hub_id = scaleway_iot_hub.test.id
+						status = "disabled"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_iot_devices.by_hub", "devices.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_iot_devices.by_hub", "device_count", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_iot_devices.by_hub", "connected_device_count", "0"),
+
+					resource.TestCheckResourceAttr("data.scaleway_iot_devices.by_name", "devices.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iot_devices.by_name", "devices.0.id", "scaleway_iot_device.dev1", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_iot_devices.by_name", "devices.0.hub_id", "scaleway_iot_hub.test", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_iot_devices.by_name", "devices.0.status", "enabled"),
+					resource.TestCheckResourceAttr("data.scaleway_iot_devices.by_name", "devices.0.is_connected", "false"),
+
+					resource.TestCheckResourceAttr("data.scaleway_iot_devices.by_status", "devices.#", "0"),
+					resource.TestCheckResourceAttr("data.scaleway_iot_devices.by_status", "device_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceScalewayIotDevicesRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/iot/v1/regions/fr-par/devices", r.URL.Path)
+		assert.Equal(t, "11111111-1111-1111-1111-111111111111", r.URL.Query().Get("hub_id"))
+		// The status is filtered by the provider, the request always has the zero value
+		assert.Equal(t, "unknown", r.URL.Query().Get("status"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"devices":[
+			{"id":"dev1","name":"dev1","hub_id":"11111111-1111-1111-1111-111111111111","status":"enabled","is_connected":true},
+			{"id":"dev2","name":"dev2","hub_id":"11111111-1111-1111-1111-111111111111","status":"enabled","is_connected":false},
+			{"id":"dev3","name":"dev3","hub_id":"11111111-1111-1111-1111-111111111111","status":"disabled","is_connected":true}
+		],"total_count":3}`))
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL), scw.WithDefaultRegion(scw.RegionFrPar))
+	require.NoError(t, err)
+	meta := &Meta{scwClient: client}
+
+	tests := []struct {
+		name                   string
+		status                 string
+		expectedDeviceCount    int
+		expectedConnectedCount int
+	}{
+		{name: "all devices", expectedDeviceCount: 3, expectedConnectedCount: 2},
+		{name: "enabled devices", status: "enabled", expectedDeviceCount: 2, expectedConnectedCount: 1},
+		{name: "error devices", status: "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceScalewayIotDevices().Schema, map[string]interface{}{
+				"hub_id": "fr-par/11111111-1111-1111-1111-111111111111",
+				"status": tt.status,
+			})
+
+			diags := dataSourceScalewayIotDevicesRead(context.Background(), d, meta)
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.expectedDeviceCount, d.Get("device_count"))
+			assert.Equal(t, tt.expectedDeviceCount, d.Get("devices.#"))
+			assert.Equal(t, tt.expectedConnectedCount, d.Get("connected_device_count"))
+		})
+	}
+}
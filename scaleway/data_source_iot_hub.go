@@ -13,6 +13,7 @@ func dataSourceScalewayIotHub() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayIotHub().Schema)
 
 	addOptionalFieldsToSchema(dsSchema, "name", "region")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"hub_id"}
 	dsSchema["hub_id"] = &schema.Schema{
@@ -32,7 +33,7 @@ func dataSourceScalewayIotHub() *schema.Resource {
 func dataSourceScalewayIotHubRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := iotAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	hubID, ok := d.GetOk("hub_id")
@@ -41,9 +42,9 @@ func dataSourceScalewayIotHubRead(ctx context.Context, d *schema.ResourceData, m
 			Region:    region,
 			ProjectID: expandStringPtr(d.Get("project_id")),
 			Name:      expandStringPtr(d.Get("name")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, hub := range res.Hubs {
 			if hub.Name == d.Get("name").(string) {
@@ -62,7 +63,7 @@ func dataSourceScalewayIotHubRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(regionalID)
 	err = d.Set("hub_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	diags := resourceScalewayIotHubRead(ctx, d, meta)
 	if diags != nil {
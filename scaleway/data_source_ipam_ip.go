@@ -78,7 +78,7 @@ func dataSourceScalewayIPAMIP() *schema.Resource {
 func dataSourceScalewayIPAMIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := ipamAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &ipam.ListIPsRequest{ // TODO: add missing filters
@@ -110,13 +110,13 @@ func dataSourceScalewayIPAMIPRead(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := api.ListIPs(req, scw.WithAllPages(), scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if len(resp.IPs) == 0 {
-		return diag.FromErr(fmt.Errorf("no ip found with given filters"))
+		return diagFromErr(fmt.Errorf("no ip found with given filters"))
 	}
 	if len(resp.IPs) > 1 {
-		return diag.FromErr(fmt.Errorf("more than one ip found with given filter"))
+		return diagFromErr(fmt.Errorf("more than one ip found with given filter"))
 	}
 
 	ip := resp.IPs[0]
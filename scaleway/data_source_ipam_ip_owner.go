@@ -0,0 +1,124 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayIPAMIPOwner() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayIPAMIPOwnerRead,
+		Schema: map[string]*schema.Schema{
+			// Input
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "The IP address to look up",
+			},
+			"private_network_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the private network the IP belongs to",
+			},
+			"project_id": datasourceProjectIDSchema(),
+			"region":     regionSchema(),
+
+			// Computed
+			"ip_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the IP in IPAM",
+			},
+			"is_ipv6": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the IP is an IPv6",
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the resource the IP is bound to",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the resource the IP is bound to",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the resource the IP is bound to",
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The MAC address of the resource the IP is bound to",
+			},
+		},
+	}
+}
+
+func dataSourceScalewayIPAMIPOwnerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, region, err := ipamAPIWithRegion(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	address := net.ParseIP(d.Get("address").(string))
+	privateNetworkID := expandStringPtr(expandLastID(d.Get("private_network_id")))
+
+	resp, err := api.ListIPs(&ipam.ListIPsRequest{
+		Region:           region,
+		ProjectID:        expandStringPtr(d.Get("project_id")),
+		PrivateNetworkID: privateNetworkID,
+		IsIPv6:           scw.BoolPtr(address.To4() == nil),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	var matchingIPs []*ipam.IP
+	for _, ip := range resp.IPs {
+		if ip.Address.IP.Equal(address) {
+			matchingIPs = append(matchingIPs, ip)
+		}
+	}
+	if len(matchingIPs) == 0 {
+		return diagFromErr(fmt.Errorf("no ip found with the address %s", address))
+	}
+	if len(matchingIPs) > 1 {
+		return diagFromErr(fmt.Errorf("%d ips found with the address %s, set private_network_id to select one", len(matchingIPs), address))
+	}
+	ip := matchingIPs[0]
+
+	if privateNetworkID == nil && ip.SubnetID != nil {
+		privateNetworkID, err = findVPCPrivateNetworkIDBySubnetID(ctx, meta, region, ip.ProjectID, *ip.SubnetID)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	d.SetId(newRegionalIDString(region, ip.ID))
+	_ = d.Set("ip_id", newRegionalIDString(region, ip.ID))
+	_ = d.Set("is_ipv6", ip.IsIPv6)
+	if privateNetworkID != nil {
+		_ = d.Set("private_network_id", newRegionalIDString(region, *privateNetworkID))
+	}
+	if ip.Resource != nil {
+		_ = d.Set("resource_type", ip.Resource.Type.String())
+		_ = d.Set("resource_id", ip.Resource.ID)
+		_ = d.Set("resource_name", flattenStringPtr(ip.Resource.Name))
+		_ = d.Set("mac_address", flattenStringPtr(ip.Resource.MacAddress))
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package scaleway
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceIPAMIPOwner_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayInstanceServerDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_vpc" "main" {
+						name = "tf-tests-ipam-ip-owner-datasource"
+					}
+
+					resource "scaleway_vpc_private_network" "main" {
+						vpc_id = scaleway_vpc.main.id
+						name   = "tf-tests-ipam-ip-owner-datasource"
+					}
+
+					resource "scaleway_instance_server" "main" {
+						name  = "tf-tests-ipam-ip-owner-datasource"
+						image = "ubuntu_jammy"
+						type  = "PLAY2-MICRO"
+						tags  = [ "terraform-test", "data_scaleway_ipam_ip_owner", "basic" ]
+					}
+
+					resource "scaleway_instance_private_nic" "main" {
+						private_network_id = scaleway_vpc_private_network.main.id
+						server_id          = scaleway_instance_server.main.id
+					}
+
+					data "scaleway_ipam_ip" "main" {
+						mac_address = scaleway_instance_private_nic.main.mac_address
+						type        = "ipv4"
+					}
+
+					data "scaleway_ipam_ip_owner" "main" {
+						address = data.scaleway_ipam_ip.main.address
+					}
+
+					data "scaleway_ipam_ip_owner" "in_private_network" {
+						address            = data.scaleway_ipam_ip.main.address
+						private_network_id = scaleway_vpc_private_network.main.id
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.scaleway_ipam_ip_owner.main", "ip_id"),
+					resource.TestCheckResourceAttr("data.scaleway_ipam_ip_owner.main", "is_ipv6", "false"),
+					resource.TestCheckResourceAttr("data.scaleway_ipam_ip_owner.main", "resource_type", "instance_private_nic"),
+					resource.TestCheckResourceAttrPair("data.scaleway_ipam_ip_owner.main", "mac_address", "scaleway_instance_private_nic.main", "mac_address"),
+					resource.TestCheckResourceAttrPair("data.scaleway_ipam_ip_owner.main", "private_network_id", "scaleway_vpc_private_network.main", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_ipam_ip_owner.in_private_network", "ip_id", "data.scaleway_ipam_ip_owner.main", "ip_id"),
+				),
+			},
+			{
+				Config: `
+					data "scaleway_ipam_ip_owner" "unknown" {
+						address = "192.0.2.1"
+					}`,
+				ExpectError: regexp.MustCompile("no ip found with the address 192.0.2.1"),
+			},
+		},
+	})
+}
@@ -16,6 +16,7 @@ func dataSourceScalewayK8SCluster() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "region")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 	delete(dsSchema, "delete_additional_resources")
 
 	dsSchema["name"].ConflictsWith = []string{"cluster_id"}
@@ -37,7 +38,7 @@ func dataSourceScalewayK8SCluster() *schema.Resource {
 func dataSourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	clusterID, ok := d.GetOk("cluster_id")
@@ -46,20 +47,20 @@ func dataSourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceDat
 			Region:    region,
 			Name:      expandStringPtr(d.Get("name")),
 			ProjectID: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, cluster := range res.Clusters {
 			if cluster.Name == d.Get("name").(string) {
 				if clusterID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 cluster found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 cluster found with the same name %s", d.Get("name")))
 				}
 				clusterID = cluster.ID
 			}
 		}
 		if clusterID == "" {
-			return diag.FromErr(fmt.Errorf("no cluster found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no cluster found with the name %s", d.Get("name")))
 		}
 	}
 
@@ -0,0 +1,173 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayK8SClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayK8SClustersRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Clusters with a name like it are listed.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Clusters with this type are listed.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Clusters with this status are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Clusters with all these tags are listed.",
+			},
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"status": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"version": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"upgrade_available": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"auto_upgrade_enabled": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"cni": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"apiserver_url": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"private_network_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"updated_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"region":          regionSchema(),
+						"organization_id": organizationIDSchema(),
+						"project_id":      projectIDSchema(),
+					},
+				},
+			},
+			"region":          regionSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayK8SClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	res, err := k8sAPI.ListClusters(&k8s.ListClustersRequest{
+		Region:    region,
+		Name:      expandStringPtr(d.Get("name")),
+		Type:      expandStringPtr(d.Get("type")),
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// Clusters are filtered by status here as the SDK sends the unknown status when it is not set.
+	// The API does not filter clusters by tags either.
+	status, filterStatus := d.GetOk("status")
+	tags := expandStrings(d.Get("tags"))
+
+	clusters := []interface{}(nil)
+	for _, cluster := range res.Clusters {
+		if filterStatus && cluster.Status.String() != status.(string) {
+			continue
+		}
+		if !k8sClusterHasTags(cluster, tags) {
+			continue
+		}
+
+		rawCluster := make(map[string]interface{})
+		rawCluster["id"] = newRegionalIDString(region, cluster.ID)
+		rawCluster["name"] = cluster.Name
+		rawCluster["type"] = cluster.Type
+		rawCluster["status"] = cluster.Status.String()
+		rawCluster["version"] = cluster.Version
+		rawCluster["upgrade_available"] = cluster.UpgradeAvailable
+		if cluster.AutoUpgrade != nil {
+			rawCluster["auto_upgrade_enabled"] = cluster.AutoUpgrade.Enabled
+		}
+		rawCluster["cni"] = cluster.Cni.String()
+		rawCluster["apiserver_url"] = cluster.ClusterURL
+		if cluster.PrivateNetworkID != nil {
+			rawCluster["private_network_id"] = newRegionalIDString(region, *cluster.PrivateNetworkID)
+		}
+		if len(cluster.Tags) > 0 {
+			rawCluster["tags"] = cluster.Tags
+		}
+		rawCluster["created_at"] = flattenTime(cluster.CreatedAt)
+		rawCluster["updated_at"] = flattenTime(cluster.UpdatedAt)
+		rawCluster["region"] = region.String()
+		rawCluster["organization_id"] = cluster.OrganizationID
+		rawCluster["project_id"] = cluster.ProjectID
+
+		clusters = append(clusters, rawCluster)
+	}
+
+	d.SetId(region.String())
+	_ = d.Set("clusters", clusters)
+
+	return nil
+}
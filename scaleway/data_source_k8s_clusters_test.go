@@ -0,0 +1,84 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccScalewayDataSourceK8SClusters_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	clustersConfig := `
+		data "scaleway_k8s_version" "latest" {
+			name = "latest"
+		}
+
+		resource "scaleway_k8s_cluster" "cluster1" {
+			name                        = "tf-k8s-datasource0"
+			version                     = data.scaleway_k8s_version.latest.name
+			cni                         = "cilium"
+			tags                        = [ "terraform-test", "data_scaleway_k8s_clusters", "basic" ]
+			delete_additional_resources = true
+		}
+
+		resource "scaleway_k8s_cluster" "cluster2" {
+			name                        = "tf-k8s-datasource1"
+			version                     = data.scaleway_k8s_version.latest.name
+			cni                         = "calico"
+			tags                        = [ "terraform-test", "data_scaleway_k8s_clusters", "other" ]
+			delete_additional_resources = true
+		}
+	`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayK8SClusterDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: clustersConfig,
+			},
+			{
+				Config: clustersConfig + `
+					data "scaleway_k8s_clusters" "by_name" {
+						name = "tf-k8s-datasource"
+					}
+
+					data "scaleway_k8s_clusters" "by_tag" {
+						tags = [ "data_scaleway_k8s_clusters", "basic" ]
+					}
+
+					data "scaleway_k8s_clusters" "by_status" {
+						name   = "tf-k8s-datasource"
+						status = "pool_required"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_k8s_clusters.by_name", "clusters.#", "2"),
+
+					resource.TestCheckResourceAttr("data.scaleway_k8s_clusters.by_tag", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_clusters.by_tag", "clusters.0.id", "scaleway_k8s_cluster.cluster1", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_k8s_clusters.by_tag", "clusters.0.name", "tf-k8s-datasource0"),
+					resource.TestCheckResourceAttr("data.scaleway_k8s_clusters.by_tag", "clusters.0.cni", "cilium"),
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_clusters.by_tag", "clusters.0.version", "data.scaleway_k8s_version.latest", "name"),
+					resource.TestCheckResourceAttrPair("data.scaleway_k8s_clusters.by_tag", "clusters.0.apiserver_url", "scaleway_k8s_cluster.cluster1", "apiserver_url"),
+					resource.TestCheckResourceAttrSet("data.scaleway_k8s_clusters.by_tag", "clusters.0.created_at"),
+
+					resource.TestCheckResourceAttr("data.scaleway_k8s_clusters.by_status", "clusters.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestK8SClusterHasTags(t *testing.T) {
+	cluster := &k8s.Cluster{Tags: []string{"terraform-test", "basic"}}
+
+	assert.True(t, k8sClusterHasTags(cluster, nil))
+	assert.True(t, k8sClusterHasTags(cluster, []string{"basic"}))
+	assert.True(t, k8sClusterHasTags(cluster, []string{"basic", "terraform-test"}))
+	assert.False(t, k8sClusterHasTags(cluster, []string{"basic", "other"}))
+	assert.False(t, k8sClusterHasTags(&k8s.Cluster{}, []string{"basic"}))
+}
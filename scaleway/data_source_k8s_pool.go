@@ -38,7 +38,7 @@ func dataSourceScalewayK8SPool() *schema.Resource {
 func dataSourceScalewayK8SPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	poolID, ok := d.GetOk("pool_id")
@@ -48,20 +48,20 @@ func dataSourceScalewayK8SPoolRead(ctx context.Context, d *schema.ResourceData,
 			Region:    region,
 			Name:      expandStringPtr(d.Get("name")),
 			ClusterID: clusterID.ID,
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, pool := range res.Pools {
 			if pool.Name == d.Get("name").(string) {
 				if poolID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 pool found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 pool found with the same name %s", d.Get("name")))
 				}
 				poolID = pool.ID
 			}
 		}
 		if poolID == "" {
-			return diag.FromErr(fmt.Errorf("no pool found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no pool found with the name %s", d.Get("name")))
 		}
 	}
 
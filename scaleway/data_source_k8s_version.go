@@ -51,12 +51,12 @@ func dataSourceScalewayK8SVersion() *schema.Resource {
 func dataSourceScalewayK8SVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	name, ok := d.GetOk("name")
 	if !ok {
-		return diag.FromErr(fmt.Errorf("could not find version %q", name))
+		return diagFromErr(fmt.Errorf("could not find version %q", name))
 	}
 
 	var version *k8s.Version
@@ -66,10 +66,10 @@ func dataSourceScalewayK8SVersionRead(ctx context.Context, d *schema.ResourceDat
 			Region: region,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Versions) == 0 {
-			return diag.FromErr(fmt.Errorf("could not find the latest version"))
+			return diagFromErr(fmt.Errorf("could not find the latest version"))
 		}
 
 		version = res.Versions[0]
@@ -79,7 +79,7 @@ func dataSourceScalewayK8SVersionRead(ctx context.Context, d *schema.ResourceDat
 			VersionName: name.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		version = res
 	}
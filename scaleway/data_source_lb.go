@@ -16,6 +16,7 @@ func dataSourceScalewayLb() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "zone")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"lb_id"}
 	dsSchema["lb_id"] = &schema.Schema{
@@ -41,7 +42,7 @@ func dataSourceScalewayLb() *schema.Resource {
 func dataSourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lbID, ok := d.GetOk("lb_id")
@@ -50,28 +51,28 @@ func dataSourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta
 			Zone:      zone,
 			Name:      expandStringPtr(d.Get("name")),
 			ProjectID: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.LBs) == 0 {
-			return diag.FromErr(fmt.Errorf("no lbs found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no lbs found with the name %s", d.Get("name")))
 		}
 		if len(res.LBs) > 1 {
-			return diag.FromErr(fmt.Errorf("%d lbs found with the same name %s", len(res.LBs), d.Get("name")))
+			return diagFromErr(fmt.Errorf("%d lbs found with the same name %s", len(res.LBs), d.Get("name")))
 		}
 		lbID = res.LBs[0].ID
 	}
 
 	err = d.Set("release_ip", false)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	zonedID := datasourceNewZonedID(lbID, zone)
 	d.SetId(zonedID)
 	err = d.Set("lb_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayLbRead(ctx, d, meta)
 }
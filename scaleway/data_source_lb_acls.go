@@ -135,21 +135,21 @@ func dataSourceScalewayLbACLs() *schema.Resource {
 func dataSourceScalewayLbACLsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, frontID, err := parseZonedID(d.Get("frontend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := lbAPI.ListACLs(&lb.ZonedAPIListACLsRequest{
 		Zone:       zone,
 		FrontendID: frontID,
 		Name:       expandStringPtr(d.Get("name")),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	acls := []interface{}(nil)
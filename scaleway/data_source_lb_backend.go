@@ -35,7 +35,7 @@ func dataSourceScalewayLbBackend() *schema.Resource {
 func dataSourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	backID, ok := d.GetOk("backend_id")
@@ -44,15 +44,15 @@ func dataSourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData
 			Zone: zone,
 			Name: expandStringPtr(d.Get("name")),
 			LBID: expandID(d.Get("lb_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Backends) == 0 {
-			return diag.FromErr(fmt.Errorf("no backends found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no backends found with the name %s", d.Get("name")))
 		}
 		if len(res.Backends) > 1 {
-			return diag.FromErr(fmt.Errorf("%d backend found with the same name %s", len(res.Backends), d.Get("name")))
+			return diagFromErr(fmt.Errorf("%d backend found with the same name %s", len(res.Backends), d.Get("name")))
 		}
 		backID = res.Backends[0].ID
 	}
@@ -60,7 +60,7 @@ func dataSourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData
 	d.SetId(zonedID)
 	err = d.Set("backend_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayLbBackendRead(ctx, d, meta)
 }
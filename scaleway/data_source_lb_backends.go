@@ -195,21 +195,21 @@ func dataSourceScalewayLbBackends() *schema.Resource {
 func dataSourceScalewayLbBackendsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, lbID, err := parseZonedID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := lbAPI.ListBackends(&lb.ZonedAPIListBackendsRequest{
 		Zone: zone,
 		LBID: lbID,
 		Name: expandStringPtr(d.Get("name")),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	backends := []interface{}(nil)
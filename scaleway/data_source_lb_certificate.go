@@ -35,7 +35,7 @@ func dataSourceScalewayLbCertificate() *schema.Resource {
 func dataSourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	crtID, ok := d.GetOk("certificate_id")
@@ -44,15 +44,15 @@ func dataSourceScalewayLbCertificateRead(ctx context.Context, d *schema.Resource
 			Zone: zone,
 			Name: expandStringPtr(d.Get("name")),
 			LBID: expandID(d.Get("lb_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Certificates) == 0 {
-			return diag.FromErr(fmt.Errorf("no certificates found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no certificates found with the name %s", d.Get("name")))
 		}
 		if len(res.Certificates) > 1 {
-			return diag.FromErr(fmt.Errorf("%d certificate found with the same name %s", len(res.Certificates), d.Get("name")))
+			return diagFromErr(fmt.Errorf("%d certificate found with the same name %s", len(res.Certificates), d.Get("name")))
 		}
 		crtID = res.Certificates[0].ID
 	}
@@ -60,7 +60,7 @@ func dataSourceScalewayLbCertificateRead(ctx context.Context, d *schema.Resource
 	d.SetId(zonedID)
 	err = d.Set("certificate_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayLbCertificateRead(ctx, d, meta)
 }
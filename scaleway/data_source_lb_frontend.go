@@ -35,7 +35,7 @@ func dataSourceScalewayLbFrontend() *schema.Resource {
 func dataSourceScalewayLbFrontendRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	frontID, ok := d.GetOk("frontend_id")
@@ -44,15 +44,15 @@ func dataSourceScalewayLbFrontendRead(ctx context.Context, d *schema.ResourceDat
 			Zone: zone,
 			Name: expandStringPtr(d.Get("name")),
 			LBID: expandID(d.Get("lb_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if len(res.Frontends) == 0 {
-			return diag.FromErr(fmt.Errorf("no frontends found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no frontends found with the name %s", d.Get("name")))
 		}
 		if len(res.Frontends) > 1 {
-			return diag.FromErr(fmt.Errorf("%d frontend found with the same name %s", len(res.Frontends), d.Get("name")))
+			return diagFromErr(fmt.Errorf("%d frontend found with the same name %s", len(res.Frontends), d.Get("name")))
 		}
 		frontID = res.Frontends[0].ID
 	}
@@ -60,7 +60,7 @@ func dataSourceScalewayLbFrontendRead(ctx context.Context, d *schema.ResourceDat
 	d.SetId(zonedID)
 	err = d.Set("frontend_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayLbFrontendRead(ctx, d, meta)
 }
@@ -13,21 +13,25 @@ import (
 func dataSourceScalewayLbIP() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayLbIP().Schema)
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["ip_address"] = &schema.Schema{
 		Type:          schema.TypeString,
 		Optional:      true,
 		Description:   "The IP address",
-		ConflictsWith: []string{"ip_id"},
+		ConflictsWith: []string{"ip_id", "reverse"},
 	}
 	dsSchema["ip_id"] = &schema.Schema{
 		Type:          schema.TypeString,
 		Optional:      true,
 		Description:   "The ID of the IP address",
-		ConflictsWith: []string{"ip_address"},
+		ConflictsWith: []string{"ip_address", "reverse"},
 		ValidateFunc:  validationUUIDorUUIDWithLocality(),
 	}
 
+	addOptionalFieldsToSchema(dsSchema, "reverse")
+	dsSchema["reverse"].ConflictsWith = []string{"ip_id", "ip_address"}
+
 	return &schema.Resource{
 		ReadContext:   dataSourceScalewayLbIPRead,
 		Schema:        dsSchema,
@@ -41,7 +45,7 @@ func dataSourceScalewayLbIP() *schema.Resource {
 func dataSourceScalewayLbIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ipID, ok := d.GetOk("ip_id")
@@ -50,24 +54,35 @@ func dataSourceScalewayLbIPRead(ctx context.Context, d *schema.ResourceData, met
 			Zone:      zone,
 			IPAddress: expandStringPtr(d.Get("ip_address")),
 			ProjectID: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
+		}
+		ips := res.IPs
+		lookupField, lookupValue := "address", d.Get("ip_address")
+		if reverse, reverseOk := d.GetOk("reverse"); reverseOk {
+			lookupField, lookupValue = "reverse", reverse
+			ips = nil
+			for _, ip := range res.IPs {
+				if reverseDNSEquals(ip.Reverse, reverse.(string)) {
+					ips = append(ips, ip)
+				}
+			}
 		}
-		if len(res.IPs) == 0 {
-			return diag.FromErr(fmt.Errorf("no ips found with the address %s", d.Get("ip_address")))
+		if len(ips) == 0 {
+			return diagFromErr(fmt.Errorf("no ips found with the %s %s", lookupField, lookupValue))
 		}
-		if len(res.IPs) > 1 {
-			return diag.FromErr(fmt.Errorf("%d ips found with the same address %s", len(res.IPs), d.Get("ip_address")))
+		if len(ips) > 1 {
+			return diagFromErr(fmt.Errorf("%d ips found with the same %s %s", len(ips), lookupField, lookupValue))
 		}
-		ipID = res.IPs[0].ID
+		ipID = ips[0].ID
 	}
 
 	zoneID := datasourceNewZonedID(ipID, zone)
 	d.SetId(zoneID)
 	err = d.Set("ip_id", zoneID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayLbIPRead(ctx, d, meta)
 }
@@ -20,6 +20,17 @@ func dataSourceScalewayLbIPs() *schema.Resource {
 				ValidateFunc: validation.IsCIDR,
 				Description:  "IPs within a CIDR block like it are listed.",
 			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "IPs with this exact address are listed.",
+			},
+			"reverse": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IPs with this reverse domain name are listed.",
+			},
 			"ips": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -57,19 +68,23 @@ func dataSourceScalewayLbIPs() *schema.Resource {
 func dataSourceScalewayLbIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	res, err := lbAPI.ListIPs(&lb.ZonedAPIListIPsRequest{
 		Zone:      zone,
+		IPAddress: expandStringPtr(d.Get("ip_address")),
 		ProjectID: expandStringPtr(d.Get("project_id")),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var filteredList []*lb.IP
 	for i := range res.IPs {
-		if ipv4Match(d.Get("ip_cidr_range").(string), res.IPs[i].IPAddress) {
+		if reverse, ok := d.GetOk("reverse"); ok && !reverseDNSEquals(res.IPs[i].Reverse, reverse.(string)) {
+			continue
+		}
+		if cidrRange := d.Get("ip_cidr_range").(string); cidrRange == "" || ipv4Match(cidrRange, res.IPs[i].IPAddress) {
 			filteredList = append(filteredList, res.IPs[i])
 		}
 	}
@@ -27,7 +27,7 @@ func dataSourceScalewayLbRoute() *schema.Resource {
 func dataSourceScalewayLbRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	routeID, _ := d.GetOk("route_id")
@@ -36,7 +36,7 @@ func dataSourceScalewayLbRouteRead(ctx context.Context, d *schema.ResourceData,
 	d.SetId(zonedID)
 	err = d.Set("route_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayLbRouteRead(ctx, d, meta)
 }
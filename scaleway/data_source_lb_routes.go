@@ -64,20 +64,20 @@ func dataSourceScalewayLbRoutes() *schema.Resource {
 func dataSourceScalewayLbRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, frontID, err := parseZonedID(d.Get("frontend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := lbAPI.ListRoutes(&lb.ZonedAPIListRoutesRequest{
 		Zone:       zone,
 		FrontendID: expandStringPtr(frontID),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	routes := []interface{}(nil)
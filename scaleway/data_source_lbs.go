@@ -154,15 +154,15 @@ func dataSourceScalewayLbs() *schema.Resource {
 func dataSourceScalewayLbsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	res, err := lbAPI.ListLBs(&lb.ZonedAPIListLBsRequest{
 		Zone:      zone,
 		Name:      expandStringPtr(d.Get("name")),
 		ProjectID: expandStringPtr(d.Get("project_id")),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lbs := []interface{}(nil)
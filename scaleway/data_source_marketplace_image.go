@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,9 +15,18 @@ func dataSourceScalewayMarketplaceImage() *schema.Resource {
 		ReadContext: dataSourceScalewayMarketplaceImageRead,
 		Schema: map[string]*schema.Schema{
 			"label": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Exact label of the desired image",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Exact label of the desired image",
+				ExactlyOneOf: []string{"label", "image_id"},
+			},
+			"image_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The ID of a local image to get the label and version of",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
 			},
 			"instance_type": {
 				Type:        schema.TypeString,
@@ -25,6 +35,30 @@ func dataSourceScalewayMarketplaceImage() *schema.Resource {
 				Description: "The instance commercial type of the desired image",
 			},
 			"zone": zoneSchema(),
+			// Computed
+			"arch": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The architecture of the local image",
+			},
+			"compatible_commercial_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The instance commercial types the local image is compatible with",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the image version the local image belongs to, only set when looking up by image_id",
+			},
+			"version_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the image version the local image belongs to, only set when looking up by image_id",
+			},
 		},
 	}
 }
@@ -32,24 +66,87 @@ func dataSourceScalewayMarketplaceImage() *schema.Resource {
 func dataSourceScalewayMarketplaceImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	marketplaceAPI, zone, err := marketplaceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	image, err := marketplaceAPI.GetLocalImageByLabel(&marketplace.GetLocalImageByLabelRequest{
-		ImageLabel:     d.Get("label").(string),
-		CommercialType: d.Get("instance_type").(string),
-		Zone:           zone,
-		Type:           marketplace.LocalImageTypeInstanceLocal,
-	}, scw.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
+	var image *marketplace.LocalImage
+	if imageID, ok := d.GetOk("image_id"); ok {
+		image, err = marketplaceAPI.GetLocalImage(&marketplace.GetLocalImageRequest{
+			LocalImageID: expandID(imageID),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		version, err := findMarketplaceLocalImageVersion(ctx, marketplaceAPI, image)
+		if err != nil {
+			return diagFromErr(err)
+		}
+		_ = d.Set("version_id", version.ID)
+		_ = d.Set("version_name", version.Name)
+		zone = image.Zone
+	} else {
+		image, err = getMarketplaceLocalImageByLabel(ctx, meta, marketplaceAPI, zone, d.Get("instance_type").(string), d.Get("label").(string))
+		if err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	zonedID := datasourceNewZonedID(image.ID, zone)
 	d.SetId(zonedID)
+	_ = d.Set("image_id", zonedID)
 	_ = d.Set("zone", zone)
-	_ = d.Set("label", d.Get("label"))
-	_ = d.Set("instance_type", d.Get("type"))
+	_ = d.Set("label", image.Label)
+	_ = d.Set("arch", image.Arch)
+	_ = d.Set("compatible_commercial_types", image.CompatibleCommercialTypes)
 
 	return nil
 }
+
+// findMarketplaceLocalImageVersion returns the version of the marketplace image the local image belongs to.
+// Local images do not reference their version, so the versions of the image with the same label are listed
+// from the most recent one and their local images are fetched until the requested one is found.
+func findMarketplaceLocalImageVersion(ctx context.Context, marketplaceAPI *marketplace.API, localImage *marketplace.LocalImage) (*marketplace.Version, error) {
+	images, err := marketplaceAPI.ListImages(&marketplace.ListImagesRequest{
+		IncludeEol: true,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	var image *marketplace.Image
+	for _, i := range images.Images {
+		if i.Label == localImage.Label {
+			image = i
+			break
+		}
+	}
+	if image == nil {
+		return nil, fmt.Errorf("could not find marketplace image with label %s", localImage.Label)
+	}
+
+	versions, err := marketplaceAPI.ListVersions(&marketplace.ListVersionsRequest{
+		ImageID: image.ID,
+		OrderBy: marketplace.ListVersionsRequestOrderByCreatedAtDesc,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, version := range versions.Versions {
+		localImages, err := marketplaceAPI.ListLocalImages(&marketplace.ListLocalImagesRequest{
+			VersionID: &version.ID,
+			Zone:      &localImage.Zone,
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return nil, err
+		}
+		for _, versionLocalImage := range localImages.LocalImages {
+			if versionLocalImage.ID == localImage.ID {
+				return version, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("could not find the version of local image %s with label %s", localImage.ID, localImage.Label)
+}
@@ -1,9 +1,16 @@
 package scaleway
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccScalewayDataSourceMarketplaceImage_Basic(t *testing.T) {
@@ -27,3 +34,51 @@ func TestAccScalewayDataSourceMarketplaceImage_Basic(t *testing.T) {
 		},
 	})
 }
+
+func TestFindMarketplaceLocalImageVersion(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/marketplace/v2/images":
+			_, _ = w.Write([]byte(`{"images":[{"id":"image-debian","label":"debian_bullseye"},{"id":"image-ubuntu","label":"ubuntu_jammy"}],"total_count":2}`))
+		case "/marketplace/v2/versions":
+			assert.Equal(t, "image-ubuntu", r.URL.Query().Get("image_id"))
+			assert.Equal(t, "created_at_desc", r.URL.Query().Get("order_by"))
+			_, _ = w.Write([]byte(`{"versions":[{"id":"version-3","name":"2023-08"},{"id":"version-2","name":"2023-07"},{"id":"version-1","name":"2023-06"}],"total_count":3}`))
+		case "/marketplace/v2/local-images":
+			assert.Equal(t, "fr-par-1", r.URL.Query().Get("zone"))
+			if r.URL.Query().Get("version_id") == "version-2" {
+				_, _ = w.Write([]byte(`{"local_images":[{"id":"local-image","label":"ubuntu_jammy","zone":"fr-par-1"}],"total_count":1}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"local_images":[{"id":"other-local-image","label":"ubuntu_jammy","zone":"fr-par-1"}],"total_count":1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL))
+	require.NoError(t, err)
+	api := marketplace.NewAPI(client)
+
+	version, err := findMarketplaceLocalImageVersion(context.Background(), api, &marketplace.LocalImage{
+		ID:    "local-image",
+		Label: "ubuntu_jammy",
+		Zone:  scw.ZoneFrPar1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "version-2", version.ID)
+	assert.Equal(t, "2023-07", version.Name)
+	// The lookup stops at the version owning the local image
+	assert.Len(t, requests, 4)
+
+	_, err = findMarketplaceLocalImageVersion(context.Background(), api, &marketplace.LocalImage{
+		ID:    "local-image",
+		Label: "centos_stream",
+		Zone:  scw.ZoneFrPar1,
+	})
+	assert.ErrorContains(t, err, "could not find marketplace image with label centos_stream")
+}
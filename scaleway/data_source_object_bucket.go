@@ -27,7 +27,7 @@ func dataSourceScalewayObjectBucket() *schema.Resource {
 func dataSourceScalewayObjectStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, region, err := s3ClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	bucket := d.Get("name").(string)
@@ -40,14 +40,14 @@ func dataSourceScalewayObjectStorageRead(ctx context.Context, d *schema.Resource
 	_, err = s3Client.HeadBucketWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed getting Object Storage bucket (%s): %w", bucket, err))
+		return diagFromErr(fmt.Errorf("failed getting Object Storage bucket (%s): %w", bucket, err))
 	}
 
 	acl, err := s3Client.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
 	}
 	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
 
@@ -29,7 +29,7 @@ func dataSourceScalewayObjectBucketPolicy() *schema.Resource {
 func dataSourceScalewayObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, region, err := s3ClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	bucket := expandID(d.Get("bucket"))
@@ -43,10 +43,10 @@ func dataSourceScalewayObjectBucketPolicyRead(ctx context.Context, d *schema.Res
 	})
 	if err != nil {
 		if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucketPolicy, s3.ErrCodeNoSuchBucket) {
-			return diag.FromErr(fmt.Errorf("bucket %s doesn't exist or has no policy", bucket))
+			return diagFromErr(fmt.Errorf("bucket %s doesn't exist or has no policy", bucket))
 		}
 
-		return diag.FromErr(fmt.Errorf("couldn't read bucket %s policy: %s", bucket, err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket %s policy: %s", bucket, err))
 	}
 
 	policyString := "{}"
@@ -56,7 +56,7 @@ func dataSourceScalewayObjectBucketPolicyRead(ctx context.Context, d *schema.Res
 
 	policyJSON, err := structure.NormalizeJsonString(policyString)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policyString, err))
+		return diagFromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policyString, err))
 	}
 
 	_ = d.Set("policy", policyJSON)
@@ -65,7 +65,7 @@ func dataSourceScalewayObjectBucketPolicyRead(ctx context.Context, d *schema.Res
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
 	}
 	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
 
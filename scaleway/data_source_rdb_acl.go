@@ -25,7 +25,7 @@ func dataSourceScalewayRDBACL() *schema.Resource {
 func dataSourceScalewayRDBACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	instanceID, _ := d.GetOk("instance_id")
 
@@ -38,7 +38,7 @@ func dataSourceScalewayRDBACLRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(regionalID.(string))
 	err = d.Set("instance_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayRdbACLRead(ctx, d, meta)
 }
@@ -25,7 +25,7 @@ func dataSourceScalewayRDBDatabase() *schema.Resource {
 func dataSourceScalewayRDBDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	instanceID, _ := d.GetOk("instance_id")
 	dbName, _ := d.GetOk("name")
@@ -38,7 +38,7 @@ func dataSourceScalewayRDBDatabaseRead(ctx context.Context, d *schema.ResourceDa
 	d.SetId(fmt.Sprintf("%s/%s", instanceID, dbName.(string)))
 	err = d.Set("instance_id", instanceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayRdbDatabaseRead(ctx, d, meta)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 func dataSourceScalewayRDBDatabaseBackup() *schema.Resource {
@@ -13,6 +14,7 @@ func dataSourceScalewayRDBDatabaseBackup() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayRdbDatabaseBackup().Schema)
 
 	addOptionalFieldsToSchema(dsSchema, "name", "region", "instance_id")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["instance_id"].RequiredWith = []string{"name"}
 	dsSchema["backup_id"] = &schema.Schema{
@@ -32,7 +34,7 @@ func dataSourceScalewayRDBDatabaseBackup() *schema.Resource {
 func dataSourceScalewayRDBDatabaseBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	backupID, backupIDExists := d.GetOk("backup_id")
@@ -42,9 +44,9 @@ func dataSourceScalewayRDBDatabaseBackupRead(ctx context.Context, d *schema.Reso
 			Name:       expandStringPtr(d.Get("name")),
 			InstanceID: expandStringPtr(expandID(d.Get("instance_id"))),
 			ProjectID:  expandStringPtr(d.Get("project_id")),
-		})
+		}, scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, backup := range res.DatabaseBackups {
 			if backup.Name == d.Get("name").(string) {
@@ -63,7 +65,7 @@ func dataSourceScalewayRDBDatabaseBackupRead(ctx context.Context, d *schema.Reso
 	d.SetId(regionID)
 	err = d.Set("backup_id", regionID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayRdbDatabaseBackupRead(ctx, d, meta)
@@ -15,6 +15,7 @@ func dataSourceScalewayRDBInstance() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayRdbInstance().Schema)
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "region")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"instance_id"}
 	dsSchema["instance_id"] = &schema.Schema{
@@ -34,28 +35,29 @@ func dataSourceScalewayRDBInstance() *schema.Resource {
 func dataSourceScalewayRDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	instanceID, ok := d.GetOk("instance_id")
 	if !ok { // Get instance by region and name.
 		res, err := api.ListInstances(&rdb.ListInstancesRequest{
-			Region: region,
-			Name:   scw.StringPtr(d.Get("name").(string)),
-		}, scw.WithContext(ctx))
+			Region:    region,
+			Name:      scw.StringPtr(d.Get("name").(string)),
+			ProjectID: expandStringPtr(d.Get("project_id")),
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, instance := range res.Instances {
 			if instance.Name == d.Get("name").(string) {
 				if instanceID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 instance found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 instance found with the same name %s", d.Get("name")))
 				}
 				instanceID = instance.ID
 			}
 		}
 		if instanceID == "" {
-			return diag.FromErr(fmt.Errorf("no instance found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no instance found with the name %s", d.Get("name")))
 		}
 	}
 
@@ -63,7 +65,7 @@ func dataSourceScalewayRDBInstanceRead(ctx context.Context, d *schema.ResourceDa
 	d.SetId(regionalID)
 	err = d.Set("instance_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayRdbInstanceRead(ctx, d, meta)
 }
@@ -0,0 +1,87 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayRDBInstanceLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayRDBInstanceLogsRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the database instance",
+			},
+			"logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"node_name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"status": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"download_url": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"expires_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"region": regionSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayRDBInstanceLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	instanceID := expandID(d.Get("instance_id"))
+
+	// Logs are only listed, preparing them would create new log files on each read
+	res, err := api.ListInstanceLogs(&rdb.ListInstanceLogsRequest{
+		Region:     region,
+		InstanceID: instanceID,
+		OrderBy:    rdb.ListInstanceLogsRequestOrderByCreatedAtDesc,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	logs := []interface{}(nil)
+	for _, instanceLog := range res.InstanceLogs {
+		logs = append(logs, flattenRdbInstanceLog(instanceLog))
+	}
+
+	d.SetId(newRegionalIDString(region, instanceID))
+	_ = d.Set("instance_id", newRegionalIDString(region, instanceID))
+	_ = d.Set("logs", logs)
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceScalewayRDBInstanceLogsRead(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// Logs must only be listed, never prepared
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/rdb/v1/regions/fr-par/instances/11111111-1111-1111-1111-111111111111/logs", r.URL.Path)
+		assert.Equal(t, "created_at_desc", r.URL.Query().Get("order_by"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"instance_logs":[
+			{"id":"22222222-2222-2222-2222-222222222222","node_name":"main","status":"ready","download_url":"https://example.com/main.log","region":"fr-par"},
+			{"id":"33333333-3333-3333-3333-333333333333","node_name":"standby","status":"creating","region":"fr-par"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL), scw.WithDefaultRegion(scw.RegionFrPar))
+	require.NoError(t, err)
+	meta := &Meta{scwClient: client}
+
+	d := schema.TestResourceDataRaw(t, dataSourceScalewayRDBInstanceLogs().Schema, map[string]interface{}{
+		"instance_id": "fr-par/11111111-1111-1111-1111-111111111111",
+	})
+
+	diags := dataSourceScalewayRDBInstanceLogsRead(context.Background(), d, meta)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, "fr-par/11111111-1111-1111-1111-111111111111", d.Id())
+	assert.Equal(t, 2, d.Get("logs.#"))
+	assert.Equal(t, "fr-par/22222222-2222-2222-2222-222222222222", d.Get("logs.0.id"))
+	assert.Equal(t, "https://example.com/main.log", d.Get("logs.0.download_url"))
+	assert.Equal(t, "creating", d.Get("logs.1.status"))
+	assert.Equal(t, "", d.Get("logs.1.download_url"))
+}
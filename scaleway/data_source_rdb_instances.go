@@ -0,0 +1,188 @@
+package scaleway
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayRDBInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayRDBInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Database instances with a name like it are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Database instances with these exact tags are listed.",
+			},
+			"engine": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Database instances with an engine starting with it are listed (e.g. PostgreSQL or PostgreSQL-15).",
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"engine": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"node_type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"status": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"is_ha_cluster": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"backup_schedule_enabled": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"endpoints": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Computed: true,
+										Type:     schema.TypeString,
+									},
+									"ip": {
+										Computed: true,
+										Type:     schema.TypeString,
+									},
+									"port": {
+										Computed: true,
+										Type:     schema.TypeInt,
+									},
+									"hostname": {
+										Computed: true,
+										Type:     schema.TypeString,
+									},
+									"private_network_id": {
+										Computed: true,
+										Type:     schema.TypeString,
+									},
+								},
+							},
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"region":          regionSchema(),
+						"organization_id": organizationIDSchema(),
+						"project_id":      projectIDSchema(),
+					},
+				},
+			},
+			"region":          regionSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayRDBInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	res, err := rdbAPI.ListInstances(&rdb.ListInstancesRequest{
+		Region:    region,
+		Tags:      expandStrings(d.Get("tags")),
+		Name:      expandStringPtr(d.Get("name")),
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	engine := strings.ToLower(d.Get("engine").(string))
+
+	instances := []interface{}(nil)
+	for _, instance := range res.Instances {
+		if !strings.HasPrefix(strings.ToLower(instance.Engine), engine) {
+			continue
+		}
+
+		endpoints := []interface{}(nil)
+		for _, endpoint := range instance.Endpoints {
+			rawEndpoint := map[string]interface{}{
+				"id":       endpoint.ID,
+				"ip":       flattenIPPtr(endpoint.IP),
+				"port":     int(endpoint.Port),
+				"hostname": flattenStringPtr(endpoint.Hostname),
+			}
+			if endpoint.PrivateNetwork != nil {
+				pnRegion, err := endpoint.PrivateNetwork.Zone.Region()
+				if err != nil {
+					return diagFromErr(err)
+				}
+				rawEndpoint["private_network_id"] = newRegionalIDString(pnRegion, endpoint.PrivateNetwork.PrivateNetworkID)
+			}
+			endpoints = append(endpoints, rawEndpoint)
+		}
+
+		rawInstance := make(map[string]interface{})
+		rawInstance["id"] = newRegionalIDString(region, instance.ID)
+		rawInstance["name"] = instance.Name
+		rawInstance["engine"] = instance.Engine
+		rawInstance["node_type"] = instance.NodeType
+		rawInstance["status"] = instance.Status.String()
+		rawInstance["is_ha_cluster"] = instance.IsHaCluster
+		if instance.BackupSchedule != nil {
+			rawInstance["backup_schedule_enabled"] = !instance.BackupSchedule.Disabled
+		}
+		rawInstance["endpoints"] = endpoints
+		if len(instance.Tags) > 0 {
+			rawInstance["tags"] = instance.Tags
+		}
+		rawInstance["created_at"] = flattenTime(instance.CreatedAt)
+		rawInstance["region"] = region.String()
+		rawInstance["organization_id"] = instance.OrganizationID
+		rawInstance["project_id"] = instance.ProjectID
+
+		instances = append(instances, rawInstance)
+	}
+
+	d.SetId(region.String())
+	_ = d.Set("instances", instances)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceRDBInstances_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayRdbInstanceDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_rdb_instance" "pg" {
+						name      = "tf-rdb-datasource0"
+						engine    = "PostgreSQL-15"
+						node_type = "db-dev-s"
+						tags      = [ "terraform-test", "data_scaleway_rdb_instances", "basic" ]
+					}
+
+					resource "scaleway_rdb_instance" "mysql" {
+						name      = "tf-rdb-datasource1"
+						engine    = "MySQL-8"
+						node_type = "db-dev-s"
+						tags      = [ "terraform-test", "data_scaleway_rdb_instances", "basic" ]
+					}`,
+			},
+			{
+				Config: `
+					resource "scaleway_rdb_instance" "pg" {
+						name      = "tf-rdb-datasource0"
+						engine    = "PostgreSQL-15"
+						node_type = "db-dev-s"
+						tags      = [ "terraform-test", "data_scaleway_rdb_instances", "basic" ]
+					}
+
+					resource "scaleway_rdb_instance" "mysql" {
+						name      = "tf-rdb-datasource1"
+						engine    = "MySQL-8"
+						node_type = "db-dev-s"
+						tags      = [ "terraform-test", "data_scaleway_rdb_instances", "basic" ]
+					}
+
+					data "scaleway_rdb_instances" "by_name" {
+						name = "tf-rdb-datasource0"
+					}
+
+					data "scaleway_rdb_instances" "by_tag" {
+						tags = [ "data_scaleway_rdb_instances", "terraform-test" ]
+					}
+
+					data "scaleway_rdb_instances" "by_engine" {
+						tags   = [ "data_scaleway_rdb_instances", "terraform-test" ]
+						engine = "mysql"
+					}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_rdb_instances.by_name", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_rdb_instances.by_name", "instances.0.id", "scaleway_rdb_instance.pg", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_rdb_instances.by_name", "instances.0.engine", "PostgreSQL-15"),
+					resource.TestCheckResourceAttr("data.scaleway_rdb_instances.by_name", "instances.0.node_type", "db-dev-s"),
+					resource.TestCheckResourceAttr("data.scaleway_rdb_instances.by_name", "instances.0.is_ha_cluster", "false"),
+					resource.TestCheckResourceAttrSet("data.scaleway_rdb_instances.by_name", "instances.0.endpoints.0.ip"),
+					resource.TestCheckResourceAttrSet("data.scaleway_rdb_instances.by_name", "instances.0.created_at"),
+
+					resource.TestCheckResourceAttr("data.scaleway_rdb_instances.by_tag", "instances.#", "2"),
+
+					resource.TestCheckResourceAttr("data.scaleway_rdb_instances.by_engine", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_rdb_instances.by_engine", "instances.0.id", "scaleway_rdb_instance.mysql", "id"),
+				),
+			},
+		},
+	})
+}
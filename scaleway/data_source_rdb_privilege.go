@@ -25,7 +25,7 @@ func dataSourceScalewayRDBPrivilege() *schema.Resource {
 func dataSourceScalewayRDBPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	instanceID := expandID(d.Get("instance_id").(string))
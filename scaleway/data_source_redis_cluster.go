@@ -15,6 +15,7 @@ func dataSourceScalewayRedisCluster() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayRedisCluster().Schema)
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "zone")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"cluster_id"}
 	dsSchema["cluster_id"] = &schema.Schema{
@@ -34,7 +35,7 @@ func dataSourceScalewayRedisCluster() *schema.Resource {
 func dataSourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, zone, err := redisAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	clusterID, ok := d.GetOk("cluster_id")
@@ -43,20 +44,20 @@ func dataSourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceD
 			Zone:      zone,
 			Name:      expandStringPtr(d.Get("name")),
 			ProjectID: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for _, cluster := range res.Clusters {
 			if cluster.Name == d.Get("name").(string) {
 				if clusterID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 cluster found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 cluster found with the same name %s", d.Get("name")))
 				}
 				clusterID = cluster.ID
 			}
 		}
 		if clusterID == "" {
-			return diag.FromErr(fmt.Errorf("no clusters found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no clusters found with the name %s", d.Get("name")))
 		}
 	}
 
@@ -64,7 +65,7 @@ func dataSourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceD
 	d.SetId(zonedID)
 	err = d.Set("cluster_id", zonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Check if cluster exist as Read will return nil if resource does not exist
@@ -75,7 +76,7 @@ func dataSourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceD
 	}
 	_, err = api.GetCluster(getReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("no clusters found with the id %s", clusterID))
+		return diagFromErr(fmt.Errorf("no clusters found with the id %s", clusterID))
 	}
 
 	return resourceScalewayRedisClusterRead(ctx, d, meta)
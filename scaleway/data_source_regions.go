@@ -0,0 +1,52 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceScalewayRegions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayRegionsRead,
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the regions where this product is available are listed",
+			},
+			"regions": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "Regions",
+			},
+		},
+	}
+}
+
+func dataSourceScalewayRegionsRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	product := d.Get("product").(string)
+
+	regions, err := regionsForProduct(product)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	rawRegions := []string(nil)
+	for _, region := range regions {
+		rawRegions = append(rawRegions, region.String())
+	}
+
+	if product == "" {
+		d.SetId("regions")
+	} else {
+		d.SetId(product)
+	}
+	_ = d.Set("regions", rawRegions)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package scaleway
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceRegions_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data scaleway_regions main {
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_regions.main", "id", "regions"),
+					resource.TestCheckResourceAttr("data.scaleway_regions.main", "regions.0", "fr-par"),
+					resource.TestCheckResourceAttr("data.scaleway_regions.main", "regions.1", "nl-ams"),
+					resource.TestCheckResourceAttr("data.scaleway_regions.main", "regions.2", "pl-waw"),
+				),
+			},
+			{
+				Config: `
+					data scaleway_regions main {
+						product = "tem"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_regions.main", "id", "tem"),
+					resource.TestCheckResourceAttr("data.scaleway_regions.main", "regions.#", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_regions.main", "regions.0", "fr-par"),
+				),
+			},
+			{
+				Config: `
+					data scaleway_regions main {
+						product = "unknown"
+					}
+				`,
+				ExpectError: regexp.MustCompile("unknown regional product unknown"),
+			},
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 func dataSourceScalewayRegistryImage() *schema.Resource {
@@ -75,7 +76,7 @@ func dataSourceScalewayRegistryImageRead(d *schema.ResourceData, meta interface{
 			Region:      region,
 			Name:        expandStringPtr(d.Get("name")),
 			NamespaceID: namespaceID,
-		})
+		}, scw.WithAllPages())
 		if err != nil {
 			return err
 		}
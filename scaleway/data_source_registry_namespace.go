@@ -15,6 +15,7 @@ func dataSourceScalewayRegistryNamespace() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceScalewayRegistryNamespace().Schema)
 
 	addOptionalFieldsToSchema(dsSchema, "name", "region")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"namespace_id"}
 	dsSchema["namespace_id"] = &schema.Schema{
@@ -35,25 +36,34 @@ func dataSourceScalewayRegistryNamespace() *schema.Resource {
 func dataSourceScalewayRegistryNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := registryAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	namespaceID, ok := d.GetOk("namespace_id")
 	if !ok {
 		res, err := api.ListNamespaces(&registry.ListNamespacesRequest{
-			Region: region,
-			Name:   expandStringPtr(d.Get("name")),
-		}, scw.WithContext(ctx))
+			Region:    region,
+			Name:      expandStringPtr(d.Get("name")),
+			ProjectID: expandStringPtr(d.Get("project_id")),
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
-		if len(res.Namespaces) == 0 {
-			return diag.FromErr(fmt.Errorf("no namespaces found with the name %s", d.Get("name")))
+
+		// The name filter matches namespaces with a name like it, keep exact matches only
+		var namespaces []*registry.Namespace
+		for _, namespace := range res.Namespaces {
+			if namespace.Name == d.Get("name").(string) {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+		if len(namespaces) == 0 {
+			return diagFromErr(fmt.Errorf("no namespaces found with the name %s", d.Get("name")))
 		}
-		if len(res.Namespaces) > 1 {
-			return diag.FromErr(fmt.Errorf("%d namespaces found with the same name %s", len(res.Namespaces), d.Get("name")))
+		if len(namespaces) > 1 {
+			return diagFromErr(fmt.Errorf("%d namespaces found with the same name %s", len(namespaces), d.Get("name")))
 		}
-		namespaceID = res.Namespaces[0].ID
+		namespaceID = namespaces[0].ID
 	}
 
 	regionalID := datasourceNewRegionalID(namespaceID, region)
@@ -0,0 +1,118 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayRegistryNamespaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayRegistryNamespacesRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Registry namespaces with a name like it are listed.",
+			},
+			"namespaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"description": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"status": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"endpoint": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"is_public": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"size": {
+							Computed: true,
+							Type:     schema.TypeInt,
+						},
+						"image_count": {
+							Computed: true,
+							Type:     schema.TypeInt,
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"updated_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"region":          regionSchema(),
+						"organization_id": organizationIDSchema(),
+						"project_id":      projectIDSchema(),
+					},
+				},
+			},
+			"region":          regionSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayRegistryNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, region, err := registryAPIWithRegion(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	res, err := api.ListNamespaces(&registry.ListNamespacesRequest{
+		Region:    region,
+		Name:      expandStringPtr(d.Get("name")),
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	namespaces := []interface{}(nil)
+	for _, namespace := range res.Namespaces {
+		rawNamespace := make(map[string]interface{})
+		rawNamespace["id"] = newRegionalIDString(region, namespace.ID)
+		rawNamespace["name"] = namespace.Name
+		rawNamespace["description"] = namespace.Description
+		rawNamespace["status"] = namespace.Status.String()
+		rawNamespace["endpoint"] = namespace.Endpoint
+		rawNamespace["is_public"] = namespace.IsPublic
+		rawNamespace["size"] = int(namespace.Size)
+		rawNamespace["image_count"] = int(namespace.ImageCount)
+		rawNamespace["created_at"] = flattenTime(namespace.CreatedAt)
+		rawNamespace["updated_at"] = flattenTime(namespace.UpdatedAt)
+		rawNamespace["region"] = region.String()
+		rawNamespace["organization_id"] = namespace.OrganizationID
+		rawNamespace["project_id"] = namespace.ProjectID
+
+		namespaces = append(namespaces, rawNamespace)
+	}
+
+	d.SetId(region.String())
+	_ = d.Set("namespaces", namespaces)
+
+	return nil
+}
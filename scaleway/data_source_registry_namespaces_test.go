@@ -0,0 +1,63 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceRegistryNamespaces_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	namespacesConfig := `
+		resource "scaleway_registry_namespace" "ns1" {
+			name        = "tf-test-registry-namespaces-0"
+			description = "first namespace"
+			is_public   = true
+		}
+
+		resource "scaleway_registry_namespace" "ns2" {
+			name = "tf-test-registry-namespaces-1"
+		}
+	`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayRegistryNamespaceDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: namespacesConfig,
+			},
+			{
+				Config: namespacesConfig + `
+					data "scaleway_registry_namespaces" "by_name" {
+						name = "tf-test-registry-namespaces-0"
+					}
+
+					data "scaleway_registry_namespaces" "by_prefix" {
+						name = "tf-test-registry-namespaces"
+					}
+
+					data "scaleway_registry_namespaces" "by_name_other_region" {
+						name   = "tf-test-registry-namespaces"
+						region = "nl-ams"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_registry_namespaces.by_name", "namespaces.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_registry_namespaces.by_name", "namespaces.0.id", "scaleway_registry_namespace.ns1", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_registry_namespaces.by_name", "namespaces.0.name", "tf-test-registry-namespaces-0"),
+					resource.TestCheckResourceAttr("data.scaleway_registry_namespaces.by_name", "namespaces.0.description", "first namespace"),
+					resource.TestCheckResourceAttr("data.scaleway_registry_namespaces.by_name", "namespaces.0.is_public", "true"),
+					resource.TestCheckResourceAttrPair("data.scaleway_registry_namespaces.by_name", "namespaces.0.endpoint", "scaleway_registry_namespace.ns1", "endpoint"),
+					resource.TestCheckResourceAttr("data.scaleway_registry_namespaces.by_name", "namespaces.0.region", "fr-par"),
+					resource.TestCheckResourceAttrSet("data.scaleway_registry_namespaces.by_name", "namespaces.0.project_id"),
+
+					resource.TestCheckResourceAttr("data.scaleway_registry_namespaces.by_prefix", "namespaces.#", "2"),
+
+					resource.TestCheckNoResourceAttr("data.scaleway_registry_namespaces.by_name_other_region", "namespaces.0.id"),
+				),
+			},
+		},
+	})
+}
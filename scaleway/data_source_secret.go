@@ -42,7 +42,7 @@ func dataSourceScalewaySecret() *schema.Resource {
 func dataSourceScalewaySecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, projectID, err := secretAPIWithRegionAndProjectID(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	secretID, ok := d.GetOk("secret_id")
@@ -57,9 +57,9 @@ func dataSourceScalewaySecretRead(ctx context.Context, d *schema.ResourceData, m
 		if organizationIDRaw, ok := d.GetOk("organization_id"); ok {
 			request.OrganizationID = scw.StringPtr(organizationIDRaw.(string))
 		}
-		res, err := api.ListSecrets(request, scw.WithContext(ctx))
+		res, err := api.ListSecrets(request, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		for _, s := range res.Secrets {
@@ -69,14 +69,14 @@ func dataSourceScalewaySecretRead(ctx context.Context, d *schema.ResourceData, m
 
 			if s.Name == d.Get("name").(string) {
 				if secretID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 secret found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 secret found with the same name %s", d.Get("name")))
 				}
 
 				secretID = newRegionalIDString(region, s.ID)
 			}
 		}
 		if res.TotalCount == 0 {
-			return diag.FromErr(fmt.Errorf("no secret found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no secret found with the name %s", d.Get("name")))
 		}
 	}
 
@@ -84,7 +84,7 @@ func dataSourceScalewaySecretRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(regionalID)
 	err = d.Set("secret_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewaySecretRead(ctx, d, meta)
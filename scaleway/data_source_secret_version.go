@@ -36,6 +36,13 @@ func dataSourceScalewaySecretVersion() *schema.Resource {
 		Sensitive:   true,
 		Description: "The payload of the secret version",
 	}
+	dsSchema["plaintext_data"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The decoded payload of the secret version",
+	}
+	dsSchema["revision"].Description = "The revision of the secret version. Can be a number or \"latest\""
 
 	return &schema.Resource{
 		ReadContext: datasourceSchemaFromResourceVersionSchema,
@@ -46,7 +53,7 @@ func dataSourceScalewaySecretVersion() *schema.Resource {
 func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := secretAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var secretVersionIDStr string
@@ -57,12 +64,12 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 		request := &secret.AccessSecretVersionByNameRequest{
 			Region:     region,
 			SecretName: d.Get("secret_name").(string),
-			Revision:   d.Get("revision").(string),
+			Revision:   expandStringWithDefault(d.Get("revision"), secretVersionRevisionLatest),
 		}
 
 		res, err := api.AccessSecretVersionByName(request, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		secretVersionIDStr = newRegionalIDString(region, fmt.Sprintf("%s/%d", res.SecretID, res.Revision))
@@ -72,12 +79,12 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 		request := &secret.AccessSecretVersionRequest{
 			Region:   region,
 			SecretID: expandID(secretID),
-			Revision: d.Get("revision").(string),
+			Revision: expandStringWithDefault(d.Get("revision"), secretVersionRevisionLatest),
 		}
 
 		res, err := api.AccessSecretVersion(request, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		secretVersionIDStr = newRegionalIDString(region, fmt.Sprintf("%s/%d", res.SecretID, res.Revision))
@@ -87,7 +94,11 @@ func datasourceSchemaFromResourceVersionSchema(ctx context.Context, d *schema.Re
 	d.SetId(secretVersionIDStr)
 	err = d.Set("data", base64.StdEncoding.EncodeToString(payloadSecretRaw))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+	err = d.Set("plaintext_data", string(payloadSecretRaw))
+	if err != nil {
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewaySecretVersionRead(ctx, d, meta)
@@ -16,6 +16,7 @@ func dataSourceScalewayTemDomain() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "region")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"domain_id"}
 	dsSchema["domain_id"] = &schema.Schema{
@@ -36,7 +37,7 @@ func dataSourceScalewayTemDomain() *schema.Resource {
 func dataSourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := temAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	domainID, ok := d.GetOk("domain_id")
@@ -45,9 +46,9 @@ func dataSourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData
 			Region:    region,
 			Name:      expandStringPtr(d.Get("name")),
 			ProjectID: expandStringPtr(d.Get("project_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		for _, domain := range res.Domains {
@@ -57,7 +58,7 @@ func dataSourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData
 
 			if domain.Name == d.Get("name").(string) {
 				if domainID != "" {
-					return diag.FromErr(fmt.Errorf("more than 1 server found with the same name %s", d.Get("name")))
+					return diagFromErr(fmt.Errorf("more than 1 server found with the same name %s", d.Get("name")))
 				}
 
 				domainID = domain.ID
@@ -65,7 +66,7 @@ func dataSourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData
 		}
 
 		if domainID == "" {
-			return diag.FromErr(fmt.Errorf("no domain found with the name %s", d.Get("name")))
+			return diagFromErr(fmt.Errorf("no domain found with the name %s", d.Get("name")))
 		}
 	}
 
@@ -73,7 +74,7 @@ func dataSourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData
 	d.SetId(regionalID)
 	err = d.Set("domain_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayTemDomainRead(ctx, d, meta)
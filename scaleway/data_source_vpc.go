@@ -40,7 +40,7 @@ func dataSourceScalewayVPC() *schema.Resource {
 func dataSourceScalewayVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var vpcID interface{}
@@ -52,9 +52,9 @@ func dataSourceScalewayVPCRead(ctx context.Context, d *schema.ResourceData, meta
 			Region:    region,
 		}
 
-		res, err := vpcAPI.ListVPCs(request, scw.WithContext(ctx))
+		res, err := vpcAPI.ListVPCs(request, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		vpcID = newRegionalIDString(region, res.Vpcs[0].ID)
@@ -68,21 +68,21 @@ func dataSourceScalewayVPCRead(ctx context.Context, d *schema.ResourceData, meta
 				OrganizationID: expandStringPtr(d.Get("organization_id")),
 			}
 
-			res, err := vpcAPI.ListVPCs(request, scw.WithContext(ctx))
+			res, err := vpcAPI.ListVPCs(request, scw.WithContext(ctx), scw.WithAllPages())
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 
 			for _, v := range res.Vpcs {
 				if v.Name == d.Get("name").(string) {
 					if vpcID != "" {
-						return diag.FromErr(fmt.Errorf("more than 1 VPC found with the same name %s", d.Get("name")))
+						return diagFromErr(fmt.Errorf("more than 1 VPC found with the same name %s", d.Get("name")))
 					}
 					vpcID = newRegionalIDString(region, v.ID)
 				}
 			}
 			if res.TotalCount == 0 {
-				return diag.FromErr(fmt.Errorf("no VPC found with the name %s", d.Get("name")))
+				return diagFromErr(fmt.Errorf("no VPC found with the name %s", d.Get("name")))
 			}
 		}
 	}
@@ -91,7 +91,7 @@ func dataSourceScalewayVPCRead(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(regionalID)
 	err = d.Set("vpc_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayVPCRead(ctx, d, meta)
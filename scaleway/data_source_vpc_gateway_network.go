@@ -40,7 +40,7 @@ func dataSourceScalewayVPCGatewayNetwork() *schema.Resource {
 func dataSourceScalewayVPCGatewayNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	gatewayNetworkID, ok := d.GetOk("gateway_network_id")
@@ -51,15 +51,15 @@ func dataSourceScalewayVPCGatewayNetworkRead(ctx context.Context, d *schema.Reso
 			EnableMasquerade: expandBoolPtr(getBool(d, "enable_masquerade")),
 			DHCPID:           expandStringPtr(expandID(d.Get("dhcp_id").(string))),
 			Zone:             zone,
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if res.TotalCount == 0 {
-			return diag.FromErr(fmt.Errorf("no gateway network found with the filters"))
+			return diagFromErr(fmt.Errorf("no gateway network found with the filters"))
 		}
 		if res.TotalCount > 1 {
-			return diag.FromErr(fmt.Errorf("%d gateway networks found with filters", res.TotalCount))
+			return diagFromErr(fmt.Errorf("%d gateway networks found with filters", res.TotalCount))
 		}
 		gatewayNetworkID = res.GatewayNetworks[0].ID
 	}
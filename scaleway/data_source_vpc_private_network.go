@@ -16,6 +16,7 @@ func dataSourceScalewayVPCPrivateNetwork() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"private_network_id"}
 	dsSchema["private_network_id"] = &schema.Schema{
@@ -35,21 +36,22 @@ func dataSourceScalewayVPCPrivateNetwork() *schema.Resource {
 func dataSourceScalewayVPCPrivateNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	privateNetworkID, ok := d.GetOk("private_network_id")
 	if !ok {
 		res, err := vpcAPI.ListPrivateNetworks(
 			&vpc.ListPrivateNetworksRequest{
-				Name:   expandStringPtr(d.Get("name").(string)),
-				Region: region,
-			}, scw.WithContext(ctx))
+				Name:      expandStringPtr(d.Get("name").(string)),
+				Region:    region,
+				ProjectID: expandStringPtr(d.Get("project_id")),
+			}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if res.TotalCount == 0 {
-			return diag.FromErr(
+			return diagFromErr(
 				fmt.Errorf(
 					"no private network found with the name %s",
 					d.Get("name"),
@@ -57,7 +59,7 @@ func dataSourceScalewayVPCPrivateNetworkRead(ctx context.Context, d *schema.Reso
 			)
 		}
 		if res.TotalCount > 1 {
-			return diag.FromErr(
+			return diagFromErr(
 				fmt.Errorf(
 					"%d private networks found with the name %s",
 					res.TotalCount,
@@ -16,6 +16,7 @@ func dataSourceScalewayVPCPublicGateway() *schema.Resource {
 
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "name", "zone")
+	dsSchema["project_id"] = datasourceProjectIDSchema()
 
 	dsSchema["name"].ConflictsWith = []string{"public_gateway_id"}
 	dsSchema["public_gateway_id"] = &schema.Schema{
@@ -35,7 +36,7 @@ func dataSourceScalewayVPCPublicGateway() *schema.Resource {
 func dataSourceScalewayVPCPublicGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if v, ok := d.GetOk("zone"); ok {
@@ -46,14 +47,15 @@ func dataSourceScalewayVPCPublicGatewayRead(ctx context.Context, d *schema.Resou
 	if !ok {
 		res, err := vpcgwAPI.ListGateways(
 			&vpcgw.ListGatewaysRequest{
-				Name: expandStringPtr(d.Get("name").(string)),
-				Zone: zone,
-			}, scw.WithContext(ctx))
+				Name:      expandStringPtr(d.Get("name").(string)),
+				Zone:      zone,
+				ProjectID: expandStringPtr(d.Get("project_id")),
+			}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if res.TotalCount == 0 {
-			return diag.FromErr(
+			return diagFromErr(
 				fmt.Errorf(
 					"no public gateway found with the name %s",
 					d.Get("name"),
@@ -61,7 +63,7 @@ func dataSourceScalewayVPCPublicGatewayRead(ctx context.Context, d *schema.Resou
 			)
 		}
 		if res.TotalCount > 1 {
-			return diag.FromErr(
+			return diagFromErr(
 				fmt.Errorf(
 					"%d public gateways found with the name %s",
 					res.TotalCount,
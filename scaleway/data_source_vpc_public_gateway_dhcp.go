@@ -27,7 +27,7 @@ func dataSourceScalewayVPCPublicGatewayDHCP() *schema.Resource {
 func dataSourceScalewayVPCPublicGatewayDHCPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	dhcpID, _ := d.GetOk("dhcp_id")
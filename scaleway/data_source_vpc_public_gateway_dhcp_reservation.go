@@ -45,7 +45,7 @@ func dataSourceScalewayVPCPublicGatewayDHCPReservation() *schema.Resource {
 func dataSourceScalewayVPCPublicGatewayDHCPReservationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	reservationIDRaw, ok := d.GetOk("reservation_id")
@@ -61,14 +61,14 @@ func dataSourceScalewayVPCPublicGatewayDHCPReservationRead(ctx context.Context,
 				&vpcgw.ListDHCPEntriesRequest{
 					GatewayNetworkID: expandStringPtr(gatewayNetworkID),
 					MacAddress:       expandStringPtr(macAddress),
-				}, scw.WithContext(ctx))
+				}, scw.WithContext(ctx), scw.WithAllPages())
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		if res.TotalCount == 0 {
-			return diag.FromErr(
+			return diagFromErr(
 				fmt.Errorf(
 					"no dhcp-entry on public gateway found with the mac_address %s",
 					d.Get("mac_address"),
@@ -76,7 +76,7 @@ func dataSourceScalewayVPCPublicGatewayDHCPReservationRead(ctx context.Context,
 			)
 		}
 		if res.TotalCount > 1 {
-			return diag.FromErr(
+			return diagFromErr(
 				fmt.Errorf(
 					"%d on public gateways found with the mac address %s",
 					res.TotalCount,
@@ -27,7 +27,7 @@ func dataSourceScalewayVPCPublicGatewayIP() *schema.Resource {
 func dataSourceScalewayVPCPublicGatewayIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	_, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ipID, _ := d.GetOk("ip_id")
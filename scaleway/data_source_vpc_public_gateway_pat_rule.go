@@ -32,7 +32,7 @@ func dataSourceScalewayVPCPublicGatewayPATRule() *schema.Resource {
 func dataSourceScalewayVPCPublicGatewayPATRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	patRuleIDRaw := d.Get("pat_rule_id")
@@ -47,7 +47,7 @@ func dataSourceScalewayVPCPublicGatewayPATRuleRead(ctx context.Context, d *schem
 		Zone:      zone,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayVPCPublicGatewayPATRuleRead(ctx, d, meta)
@@ -0,0 +1,176 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayVPCPublicGateways() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayVPCPublicGatewaysRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Public gateways with a name like it are listed.",
+			},
+			"tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Public gateways with these exact tags are listed.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Public gateways with this type are listed.",
+			},
+			"gateways": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"name": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"type": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"status": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"ip_id": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"ip_address": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"bastion_enabled": {
+							Computed: true,
+							Type:     schema.TypeBool,
+						},
+						"gateway_networks": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Computed: true,
+										Type:     schema.TypeString,
+									},
+									"private_network_id": {
+										Computed: true,
+										Type:     schema.TypeString,
+									},
+									"status": {
+										Computed: true,
+										Type:     schema.TypeString,
+									},
+								},
+							},
+						},
+						"tags": {
+							Computed: true,
+							Type:     schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"created_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"updated_at": {
+							Computed: true,
+							Type:     schema.TypeString,
+						},
+						"zone":            zoneSchema(),
+						"organization_id": organizationIDSchema(),
+						"project_id":      projectIDSchema(),
+					},
+				},
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayVPCPublicGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	res, err := vpcgwAPI.ListGateways(&vpcgw.ListGatewaysRequest{
+		Zone:      zone,
+		Name:      expandStringPtr(d.Get("name")),
+		Tags:      expandStrings(d.Get("tags")),
+		Type:      expandStringPtr(d.Get("type")),
+		ProjectID: expandStringPtr(d.Get("project_id")),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	gateways := []interface{}(nil)
+	for _, gateway := range res.Gateways {
+		gatewayNetworks := []interface{}(nil)
+		for _, gatewayNetwork := range gateway.GatewayNetworks {
+			pnRegion, err := gatewayNetwork.Zone.Region()
+			if err != nil {
+				return diagFromErr(err)
+			}
+			gatewayNetworks = append(gatewayNetworks, map[string]interface{}{
+				"id":                 newZonedIDString(zone, gatewayNetwork.ID),
+				"private_network_id": newRegionalIDString(pnRegion, gatewayNetwork.PrivateNetworkID),
+				"status":             gatewayNetwork.Status.String(),
+			})
+		}
+
+		rawGateway := make(map[string]interface{})
+		rawGateway["id"] = newZonedIDString(zone, gateway.ID)
+		rawGateway["name"] = gateway.Name
+		if gateway.Type != nil {
+			rawGateway["type"] = gateway.Type.Name
+		}
+		rawGateway["status"] = gateway.Status.String()
+		if gateway.IP != nil {
+			rawGateway["ip_id"] = newZonedIDString(zone, gateway.IP.ID)
+			rawGateway["ip_address"] = gateway.IP.Address.String()
+		}
+		rawGateway["bastion_enabled"] = gateway.BastionEnabled
+		rawGateway["gateway_networks"] = gatewayNetworks
+		if len(gateway.Tags) > 0 {
+			rawGateway["tags"] = gateway.Tags
+		}
+		rawGateway["created_at"] = flattenTime(gateway.CreatedAt)
+		rawGateway["updated_at"] = flattenTime(gateway.UpdatedAt)
+		rawGateway["zone"] = string(zone)
+		rawGateway["organization_id"] = gateway.OrganizationID
+		rawGateway["project_id"] = gateway.ProjectID
+
+		gateways = append(gateways, rawGateway)
+	}
+
+	d.SetId(zone.String())
+	_ = d.Set("gateways", gateways)
+
+	return nil
+}
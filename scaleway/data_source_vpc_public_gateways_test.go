@@ -0,0 +1,72 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceVPCPublicGateways_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	gatewaysConfig := `
+		resource "scaleway_vpc_public_gateway" "gw1" {
+			name            = "tf-gw-datasource0"
+			type            = "VPC-GW-S"
+			bastion_enabled = true
+			tags            = [ "terraform-test", "data_scaleway_vpc_public_gateways", "basic" ]
+		}
+
+		resource "scaleway_vpc_public_gateway" "gw2" {
+			name = "tf-gw-datasource1"
+			type = "VPC-GW-M"
+			tags = [ "terraform-test", "data_scaleway_vpc_public_gateways", "basic" ]
+		}
+	`
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayVPCPublicGatewayDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: gatewaysConfig,
+			},
+			{
+				Config: gatewaysConfig + `
+					data "scaleway_vpc_public_gateways" "by_name" {
+						name = "tf-gw-datasource0"
+					}
+
+					data "scaleway_vpc_public_gateways" "by_tag" {
+						tags = [ "data_scaleway_vpc_public_gateways", "terraform-test" ]
+					}
+
+					data "scaleway_vpc_public_gateways" "by_type" {
+						tags = [ "data_scaleway_vpc_public_gateways", "terraform-test" ]
+						type = "VPC-GW-M"
+					}
+
+					data "scaleway_vpc_public_gateways" "by_name_other_zone" {
+						name = "tf-gw-datasource"
+						zone = "fr-par-2"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_vpc_public_gateways.by_name", "gateways.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_vpc_public_gateways.by_name", "gateways.0.id", "scaleway_vpc_public_gateway.gw1", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_vpc_public_gateways.by_name", "gateways.0.type", "VPC-GW-S"),
+					resource.TestCheckResourceAttr("data.scaleway_vpc_public_gateways.by_name", "gateways.0.bastion_enabled", "true"),
+					resource.TestCheckResourceAttrPair("data.scaleway_vpc_public_gateways.by_name", "gateways.0.ip_id", "scaleway_vpc_public_gateway.gw1", "ip_id"),
+					resource.TestCheckResourceAttrSet("data.scaleway_vpc_public_gateways.by_name", "gateways.0.ip_address"),
+
+					resource.TestCheckResourceAttr("data.scaleway_vpc_public_gateways.by_tag", "gateways.#", "2"),
+
+					resource.TestCheckResourceAttr("data.scaleway_vpc_public_gateways.by_type", "gateways.#", "1"),
+					resource.TestCheckResourceAttrPair("data.scaleway_vpc_public_gateways.by_type", "gateways.0.id", "scaleway_vpc_public_gateway.gw2", "id"),
+
+					resource.TestCheckNoResourceAttr("data.scaleway_vpc_public_gateways.by_name_other_zone", "gateways.0.id"),
+				),
+			},
+		},
+	})
+}
@@ -74,7 +74,7 @@ func dataSourceScalewayVPCs() *schema.Resource {
 func dataSourceScalewayVPCsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := vpcAPI.ListVPCs(&vpc.ListVPCsRequest{
@@ -82,9 +82,9 @@ func dataSourceScalewayVPCsRead(ctx context.Context, d *schema.ResourceData, met
 		Tags:      expandStrings(d.Get("tags")),
 		Name:      expandStringPtr(d.Get("name")),
 		ProjectID: expandStringPtr(d.Get("project_id")),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	vpcs := []interface{}(nil)
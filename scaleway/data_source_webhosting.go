@@ -39,7 +39,7 @@ func dataSourceScalewayWebhosting() *schema.Resource {
 func dataSourceScalewayWebhostingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := webhostingAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	/*	var vpcID interface{}
@@ -51,9 +51,9 @@ func dataSourceScalewayWebhostingRead(ctx context.Context, d *schema.ResourceDat
 			Domain:         expandStringPtr(d.Get("domain")),
 			ProjectID:      expandStringPtr(d.Get("project_id")),
 			OrganizationID: expandStringPtr(d.Get("organization_id")),
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		for _, hosting := range res.Hostings {
@@ -73,11 +73,11 @@ func dataSourceScalewayWebhostingRead(ctx context.Context, d *schema.ResourceDat
 	d.SetId(regionalID)
 	err = d.Set("webhosting_id", regionalID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := resourceScalewayWebhostingRead(ctx, d, meta)
-	if diags != nil {
+	if diags.HasError() {
 		return append(diags, diag.Errorf("failed to read hosting")...)
 	}
 
@@ -85,5 +85,5 @@ func dataSourceScalewayWebhostingRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("hosting (%s) not found", regionalID)
 	}
 
-	return nil
+	return diags
 }
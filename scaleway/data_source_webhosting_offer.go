@@ -86,17 +86,17 @@ func dataSourceScalewayWebhostingOffer() *schema.Resource {
 func dataSourceScalewayWebhostingOfferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	webhostingAPI, region, err := webhostingAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := webhostingAPI.ListOffers(&webhosting.ListOffersRequest{
 		Region: region,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if len(res.Offers) == 0 {
-		return diag.FromErr(fmt.Errorf("no offer found in region %s", region))
+		return diagFromErr(fmt.Errorf("no offer found in region %s", region))
 	}
 
 	var filteredOffer *webhosting.Offer
@@ -106,7 +106,7 @@ func dataSourceScalewayWebhostingOfferRead(ctx context.Context, d *schema.Resour
 		}
 	}
 	if filteredOffer == nil {
-		return diag.FromErr(fmt.Errorf("no offer found with the name or id: %s%s in region %s", d.Get("name"), d.Get("offer_id"), region))
+		return diagFromErr(fmt.Errorf("no offer found with the name or id: %s%s in region %s", d.Get("name"), d.Get("offer_id"), region))
 	}
 
 	regionalID := datasourceNewRegionalID(filteredOffer.ID, region)
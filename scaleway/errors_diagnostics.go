@@ -0,0 +1,178 @@
+package scaleway
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+// apiErrorDetailsKey is the key of the details added to the JSON body of failed responses.
+// SDK errors keep the raw body of the response they were built from, the details are read back from it.
+const apiErrorDetailsKey = "terraform_provider_details"
+
+// apiErrorDetails contains the details of a failed API request that are not part of SDK errors.
+type apiErrorDetails struct {
+	RequestID string `json:"request_id,omitempty"`
+	Status    string `json:"status,omitempty"`
+	// PermissionSet is the IAM permission set likely required when permissions were denied
+	PermissionSet string `json:"permission_set,omitempty"`
+}
+
+// addAPIErrorDetails adds the request ID and status of a failed response to its JSON body.
+func addAPIErrorDetails(r *http.Request, resp *http.Response) {
+	if resp == nil || resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return
+	}
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	fields := map[string]json.RawMessage{}
+	if json.Unmarshal(body, &fields) != nil {
+		return
+	}
+
+	details := apiErrorDetails{
+		RequestID: resp.Header.Get(requestIDHeader),
+		Status:    resp.Status,
+	}
+	var errorType string
+	_ = json.Unmarshal(fields["type"], &errorType)
+	if errorType == "permissions_denied" {
+		details.PermissionSet = requiredPermissionSet(r)
+	}
+
+	rawDetails, err := json.Marshal(details)
+	if err != nil {
+		return
+	}
+	fields[apiErrorDetailsKey] = rawDetails
+	newBody, err := json.Marshal(fields)
+	if err != nil {
+		return
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(newBody))
+	resp.ContentLength = int64(len(newBody))
+	resp.Header.Del("Content-Length")
+}
+
+// diagFromErr is diag.FromErr with the details of the failed API request in the diagnostic
+// when the error comes from an API response.
+func diagFromErr(err error) diag.Diagnostics {
+	diags := diag.FromErr(err)
+	if detail := apiErrorDetail(err); detail != "" {
+		diags[0].Detail = detail
+	}
+	return diags
+}
+
+// apiErrorDetail returns the HTTP status, request ID, invalid arguments and permission set hint
+// of the API response an error was built from.
+func apiErrorDetail(err error) string {
+	var rawBodyError interface {
+		scw.SdkError
+		GetRawBody() json.RawMessage
+	}
+	if !errors.As(err, &rawBodyError) {
+		return ""
+	}
+
+	body := struct {
+		Details apiErrorDetails `json:"terraform_provider_details"`
+	}{}
+	_ = json.Unmarshal(rawBodyError.GetRawBody(), &body)
+	details := body.Details
+
+	var responseError *scw.ResponseError
+	if details.Status == "" && errors.As(err, &responseError) {
+		details.Status = responseError.Status
+	}
+	if details.Status == "" {
+		return ""
+	}
+
+	lines := []string{fmt.Sprintf("HTTP status: %s", details.Status)}
+	if details.RequestID != "" {
+		lines = append(lines, fmt.Sprintf("Request ID: %s", details.RequestID))
+	}
+
+	var invalidArgumentsError *scw.InvalidArgumentsError
+	if errors.As(err, &invalidArgumentsError) {
+		for _, argument := range invalidArgumentsError.Details {
+			line := fmt.Sprintf("Invalid argument %q: %s", argument.ArgumentName, argument.Reason)
+			if argument.HelpMessage != "" {
+				line += " (" + argument.HelpMessage + ")"
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	var permissionsDeniedError *scw.PermissionsDeniedError
+	if details.PermissionSet != "" && errors.As(err, &permissionsDeniedError) {
+		lines = append(lines, fmt.Sprintf("Hint: the API key may lack the %s permission set, check the IAM policy attached to its application or user", details.PermissionSet))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// productPermissionSets maps the product in API URLs to the prefix of its IAM permission sets.
+var productPermissionSets = map[string]string{
+	"account":             "ProjectManager",
+	"apple-silicon":       "AppleSilicon",
+	"baremetal":           "ElasticMetal",
+	"cockpit":             "Observability",
+	"containers":          "Containers",
+	"domain":              "DomainsDNS",
+	"flexible-ip":         "ElasticMetal",
+	"functions":           "Functions",
+	"iam":                 "IAMManager",
+	"instance":            "Instances",
+	"iot":                 "IoTHub",
+	"ipam":                "IPAM",
+	"k8s":                 "Kubernetes",
+	"lb":                  "LoadBalancers",
+	"mnq":                 "MessagingAndQueuing",
+	"rdb":                 "RelationalDatabases",
+	"redis":               "Redis",
+	"registry":            "ContainerRegistry",
+	"secret-manager":      "SecretManager",
+	"transactional-email": "TransactionalEmail",
+	"vpc":                 "PrivateNetworks",
+	"vpc-gw":              "VPCGateway",
+	"webhosting":          "WebHosting",
+}
+
+// requiredPermissionSet guesses the permission set required by a request from its URL and method,
+// e.g. "InstancesReadOnly" for a GET on /instance/v1/zones/fr-par-1/servers.
+func requiredPermissionSet(r *http.Request) string {
+	product := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[0]
+	prefix, ok := productPermissionSets[product]
+	if !ok {
+		return ""
+	}
+	// Manager permission sets have no read only variant
+	if strings.HasSuffix(prefix, "Manager") {
+		return prefix
+	}
+	if r.Method == http.MethodGet {
+		return prefix + "ReadOnly"
+	}
+	return prefix + "FullAccess"
+}
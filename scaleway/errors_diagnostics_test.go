@@ -0,0 +1,134 @@
+package scaleway
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAPIErrorServer returns an API serving the given error responses by path
+// through the retryable transport adding the details of failed requests.
+func testAPIErrorServer(t *testing.T, statusCode int, responses map[string]string) *instance.API {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(requestIDHeader, "request-"+path.Base(r.URL.Path))
+		body, found := responses[path.Base(r.URL.Path)]
+		if !found {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := scw.NewClient(
+		scw.WithoutAuth(),
+		scw.WithAPIURL(server.URL),
+		scw.WithHTTPClient(&http.Client{Transport: newRetryableTransportWithOptions(http.DefaultTransport, retryableTransportOptions{})}),
+	)
+	require.NoError(t, err)
+
+	return instance.NewAPI(client)
+}
+
+func TestDiagFromErr(t *testing.T) {
+	api := testAPIErrorServer(t, http.StatusBadRequest, map[string]string{
+		"11111111-1111-1111-1111-111111111111": `{"type":"invalid_arguments","details":[{"argument_name":"name","reason":"constraint","help_message":"must be shorter"}]}`,
+		"22222222-2222-2222-2222-222222222222": `{"type":"locked","resource":"instance_server","resource_id":"22222222-2222-2222-2222-222222222222"}`,
+		"33333333-3333-3333-3333-333333333333": `{"type":"locked","resource":"instance_server","resource_id":"22222222-2222-2222-2222-222222222222"}`,
+		"44444444-4444-4444-4444-444444444444": `{"message":"bad request"}`,
+	})
+
+	_, invalidArgumentsErr := api.GetServer(&instance.GetServerRequest{Zone: scw.ZoneFrPar1, ServerID: "11111111-1111-1111-1111-111111111111"})
+	require.Error(t, invalidArgumentsErr)
+	_, lockedErr := api.GetServer(&instance.GetServerRequest{Zone: scw.ZoneFrPar1, ServerID: "22222222-2222-2222-2222-222222222222"})
+	require.Error(t, lockedErr)
+	_, otherLockedErr := api.GetServer(&instance.GetServerRequest{Zone: scw.ZoneFrPar1, ServerID: "33333333-3333-3333-3333-333333333333"})
+	require.Error(t, otherLockedErr)
+	_, responseErr := api.GetServer(&instance.GetServerRequest{Zone: scw.ZoneFrPar1, ServerID: "44444444-4444-4444-4444-444444444444"})
+	require.Error(t, responseErr)
+
+	diags := diagFromErr(fmt.Errorf("couldn't create server: %w", invalidArgumentsErr))
+	assert.Equal(t, "HTTP status: 400 Bad Request\nRequest ID: request-11111111-1111-1111-1111-111111111111\nInvalid argument \"name\": constraint (must be shorter)", diags[0].Detail)
+
+	// Errors with the same message keep the request ID of their own response
+	assert.Equal(t, lockedErr.Error(), otherLockedErr.Error())
+	assert.Equal(t, "HTTP status: 400 Bad Request\nRequest ID: request-22222222-2222-2222-2222-222222222222", diagFromErr(lockedErr)[0].Detail)
+	assert.Equal(t, "HTTP status: 400 Bad Request\nRequest ID: request-33333333-3333-3333-3333-333333333333", diagFromErr(otherLockedErr)[0].Detail)
+
+	assert.Equal(t, "HTTP status: 400 Bad Request\nRequest ID: request-44444444-4444-4444-4444-444444444444", diagFromErr(responseErr)[0].Detail)
+
+	assert.Empty(t, diagFromErr(fmt.Errorf("failed"))[0].Detail)
+	assert.Nil(t, diagFromErr(nil))
+}
+
+func TestDiagFromErrPermissionsDenied(t *testing.T) {
+	api := testAPIErrorServer(t, http.StatusForbidden, map[string]string{
+		"11111111-1111-1111-1111-111111111111": `{"type":"permissions_denied","details":[{"resource":"instance_server","action":"write"}]}`,
+		"22222222-2222-2222-2222-222222222222": `{"type":"permissions_denied","details":[{"resource":"instance_server","action":"read"}]}`,
+	})
+
+	err := api.DeleteServer(&instance.DeleteServerRequest{Zone: scw.ZoneFrPar1, ServerID: "11111111-1111-1111-1111-111111111111"})
+	require.Error(t, err)
+	assert.IsType(t, &scw.PermissionsDeniedError{}, err)
+	assert.Equal(t, "HTTP status: 403 Forbidden\nRequest ID: request-11111111-1111-1111-1111-111111111111\nHint: the API key may lack the InstancesFullAccess permission set, check the IAM policy attached to its application or user", diagFromErr(err)[0].Detail)
+
+	_, err = api.GetServer(&instance.GetServerRequest{Zone: scw.ZoneFrPar1, ServerID: "22222222-2222-2222-2222-222222222222"})
+	require.Error(t, err)
+	assert.Equal(t, "HTTP status: 403 Forbidden\nRequest ID: request-22222222-2222-2222-2222-222222222222\nHint: the API key may lack the InstancesReadOnly permission set, check the IAM policy attached to its application or user", diagFromErr(err)[0].Detail)
+}
+
+func TestAddAPIErrorDetails(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers", nil)
+	require.NoError(t, err)
+
+	resp := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Status:     "400 Bad Request",
+		Header:     http.Header{"Content-Type": []string{"application/json"}, requestIDHeader: []string{"request"}},
+		Body:       io.NopCloser(strings.NewReader(`{"type":"invalid_arguments","details":[]}`)),
+	}
+	addAPIErrorDetails(req, resp)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"invalid_arguments","details":[],"terraform_provider_details":{"request_id":"request","status":"400 Bad Request"}}`, string(body))
+	assert.Equal(t, int64(len(body)), resp.ContentLength)
+
+	// Bodies that are not JSON are left untouched
+	resp = &http.Response{
+		StatusCode: http.StatusBadGateway,
+		Status:     "502 Bad Gateway",
+		Header:     http.Header{"Content-Type": []string{"text/html"}},
+		Body:       io.NopCloser(strings.NewReader("<html></html>")),
+	}
+	addAPIErrorDetails(req, resp)
+
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "<html></html>", string(body))
+}
+
+func TestRequiredPermissionSet(t *testing.T) {
+	for url, expected := range map[string]string{
+		"https://api.scaleway.com/instance/v1/zones/fr-par-1/servers": "InstancesReadOnly",
+		"https://api.scaleway.com/iam/v1alpha1/policies":              "IAMManager",
+		"https://api.scaleway.com/unknown/v1/things":                  "",
+	} {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, requiredPermissionSet(req), url)
+	}
+}
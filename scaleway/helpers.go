@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/namegenerator"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -344,7 +343,7 @@ func zoneSchema() *schema.Schema {
 		Optional:         true,
 		ForceNew:         true,
 		Computed:         true,
-		ValidateDiagFunc: validateStringInSliceWithWarning(allZones(), "zone"),
+		ValidateDiagFunc: validateStringInCatalog(allZones(), zoneFormat, "zone"),
 	}
 }
 
@@ -374,7 +373,7 @@ func regionSchema() *schema.Schema {
 		Optional:         true,
 		ForceNew:         true,
 		Computed:         true,
-		ValidateDiagFunc: validateStringInSliceWithWarning(allRegions(), "region"),
+		ValidateDiagFunc: validateStringInCatalog(allRegions(), regionFormat, "region"),
 	}
 }
 
@@ -396,22 +395,6 @@ func regionComputedSchema() *schema.Schema {
 	}
 }
 
-// validateStringInSliceWithWarning helps to only returns warnings in case we got a non public locality passed
-func validateStringInSliceWithWarning(correctValues []string, field string) func(i interface{}, path cty.Path) diag.Diagnostics {
-	return func(i interface{}, path cty.Path) diag.Diagnostics {
-		_, rawErr := validation.StringInSlice(correctValues, true)(i, field)
-		var res diag.Diagnostics
-		for _, e := range rawErr {
-			res = append(res, diag.Diagnostic{
-				Severity:      diag.Warning,
-				Summary:       e.Error(),
-				AttributePath: path,
-			})
-		}
-		return res
-	}
-}
-
 // newRandomName returns a random name prefixed for terraform.
 func newRandomName(prefix string) string {
 	return namegenerator.GetRandomName("tf", prefix)
@@ -545,6 +528,11 @@ func flattenIPPtr(ip *net.IP) interface{} {
 	return ip.String()
 }
 
+// reverseDNSEquals compares two reverse DNS names, ignoring case and the trailing dot
+func reverseDNSEquals(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
 func flattenStringPtr(s *string) interface{} {
 	if s == nil {
 		return ""
@@ -802,7 +790,7 @@ func validateDate() schema.SchemaValidateDiagFunc {
 		}
 		_, err := time.Parse(time.RFC3339, date)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		return nil
 	}
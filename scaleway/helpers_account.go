@@ -1,9 +1,15 @@
 package scaleway
 
 import (
+	"time"
+
 	accountV3 "github.com/scaleway/scaleway-sdk-go/api/account/v3"
 )
 
+const (
+	defaultAccountProjectTimeout = 5 * time.Minute
+)
+
 func accountV3ProjectAPI(m interface{}) *accountV3.ProjectAPI {
 	meta := m.(*Meta)
 	return accountV3.NewProjectAPI(meta.scwClient)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -387,3 +388,28 @@ func baremetalPrivateNetworkSetHash(v interface{}) int {
 
 	return StringHashcode(buf.String())
 }
+
+// waitForBaremetalBMCAccess waits for the BMC access of a server to be opened, its URL and credentials are empty until then.
+func waitForBaremetalBMCAccess(ctx context.Context, api *baremetal.API, zone scw.Zone, serverID string, timeout time.Duration) (*baremetal.BMCAccess, error) {
+	var bmcAccess *baremetal.BMCAccess
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		res, err := api.GetBMCAccess(&baremetal.GetBMCAccessRequest{
+			Zone:     zone,
+			ServerID: serverID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if res.URL == "" {
+			return resource.RetryableError(fmt.Errorf("BMC access of server %s is not opened yet", serverID))
+		}
+
+		bmcAccess = res
+		return nil
+	})
+
+	return bmcAccess, err
+}
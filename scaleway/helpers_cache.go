@@ -0,0 +1,49 @@
+package scaleway
+
+import (
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// lookupCache memoizes lookups of read-only catalog data, like server types or marketplace images,
+// so that applying many resources of the same kind doesn't fetch the same object over and over.
+// Lookups are cached for the lifetime of the provider, a nil cache doesn't cache anything.
+type lookupCache struct {
+	values sync.Map
+	// lookups collapses concurrent misses of the same key into a single lookup
+	lookups singleflight.Group
+}
+
+func newLookupCache() *lookupCache {
+	return &lookupCache{}
+}
+
+// cachedLookup returns the cached value for key or calls lookup and caches its result if it succeeded.
+// Concurrent calls for a key missing from the cache share the result of a single lookup.
+func cachedLookup[T any](c *lookupCache, key string, lookup func() (T, error)) (T, error) {
+	if c == nil {
+		return lookup()
+	}
+	if value, ok := c.values.Load(key); ok {
+		return value.(T), nil
+	}
+
+	value, err, _ := c.lookups.Do(key, func() (interface{}, error) {
+		// The value may have been stored by a lookup that completed since the check above
+		if value, ok := c.values.Load(key); ok {
+			return value, nil
+		}
+		value, err := lookup()
+		if err != nil {
+			return nil, err
+		}
+		c.values.Store(key, value)
+		return value, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return value.(T), nil
+}
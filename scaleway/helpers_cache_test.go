@@ -0,0 +1,75 @@
+package scaleway
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachedLookup(t *testing.T) {
+	assert := assert.New(t)
+	cache := newLookupCache()
+
+	calls := 0
+	lookup := func() (string, error) {
+		calls++
+		return "DEV1-S", nil
+	}
+
+	for i := 0; i < 3; i++ {
+		value, err := cachedLookup(cache, "fr-par-1/DEV1-S", lookup)
+		assert.NoError(err)
+		assert.Equal("DEV1-S", value)
+	}
+	assert.Equal(1, calls)
+
+	failures := 0
+	failingLookup := func() (string, error) {
+		failures++
+		return "", errors.New("not found")
+	}
+	for i := 0; i < 2; i++ {
+		_, err := cachedLookup(cache, "fr-par-1/unknown", failingLookup)
+		assert.Error(err)
+	}
+	assert.Equal(2, failures, "errors must not be cached")
+
+	_, err := cachedLookup(nil, "fr-par-1/DEV1-S", lookup)
+	assert.NoError(err)
+	assert.Equal(2, calls)
+}
+
+func TestCachedLookupConcurrentMisses(t *testing.T) {
+	const lookups = 10
+	cache := newLookupCache()
+
+	var calls int32
+	ready := sync.WaitGroup{}
+	release := make(chan struct{})
+	lookup := func() (string, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "DEV1-S", nil
+	}
+
+	done := sync.WaitGroup{}
+	for i := 0; i < lookups; i++ {
+		ready.Add(1)
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			ready.Done()
+			value, err := cachedLookup(cache, "fr-par-1/DEV1-S", lookup)
+			assert.NoError(t, err)
+			assert.Equal(t, "DEV1-S", value)
+		}()
+	}
+	ready.Wait()
+	close(release)
+	done.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,9 @@ import (
 
 const (
 	defaultCockpitTimeout = 5 * time.Minute
+
+	pathMetricsURL = "/api/v1/push"
+	pathLogsURL    = "/loki/api/v1/push"
 )
 
 // cockpitAPI returns a new cockpit API.
@@ -68,6 +72,40 @@ func flattenCockpitEndpoints(endpoints *cockpit.CockpitEndpoints) []map[string]i
 	}
 }
 
+func flattenCockpitPushURL(endpoints *cockpit.CockpitEndpoints) []map[string]interface{} {
+	metricsURL, err := url.JoinPath(endpoints.MetricsURL, pathMetricsURL)
+	if err != nil {
+		metricsURL = endpoints.MetricsURL + pathMetricsURL
+	}
+	logsURL, err := url.JoinPath(endpoints.LogsURL, pathLogsURL)
+	if err != nil {
+		logsURL = endpoints.LogsURL + pathLogsURL
+	}
+
+	return []map[string]interface{}{
+		{
+			"push_metrics_url": metricsURL,
+			"push_logs_url":    logsURL,
+		},
+	}
+}
+
+// getCockpitPlanID returns the ID of the plan matching the given name or ID
+func getCockpitPlanID(ctx context.Context, api *cockpit.API, targetPlan string) (string, error) {
+	plans, err := api.ListPlans(&cockpit.ListPlansRequest{}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return "", err
+	}
+
+	for _, plan := range plans.Plans {
+		if plan.Name.String() == targetPlan || plan.ID == targetPlan {
+			return plan.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("plan %s not found", targetPlan)
+}
+
 func expandCockpitTokenScopes(raw interface{}) *cockpit.TokenScopes {
 	if raw == nil || len(raw.([]interface{})) != 1 {
 		return nil
@@ -0,0 +1,20 @@
+package scaleway
+
+import (
+	"testing"
+
+	cockpit "github.com/scaleway/scaleway-sdk-go/api/cockpit/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenCockpitPushURL(t *testing.T) {
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"push_metrics_url": "https://metrics.cockpit.fr-par.scw.cloud/api/v1/push",
+			"push_logs_url":    "https://logs.cockpit.fr-par.scw.cloud/loki/api/v1/push",
+		},
+	}, flattenCockpitPushURL(&cockpit.CockpitEndpoints{
+		MetricsURL: "https://metrics.cockpit.fr-par.scw.cloud",
+		LogsURL:    "https://logs.cockpit.fr-par.scw.cloud/",
+	}))
+}
@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -219,3 +220,50 @@ func retryCreateContainerDomain(ctx context.Context, containerAPI *container.API
 		}
 	}
 }
+
+// findContainerNamespaceIDByName returns the ID of the Namespace with the given "<region>/<name>", it is used to import by name.
+func findContainerNamespaceIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, region, name, err := containerAPIWithRegionAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListNamespaces(&container.ListNamespacesRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Namespaces, name, func(element *container.Namespace) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newRegionalIDString(region, id), nil
+}
+
+// customizeDiffContainerRegistryImageDigest resolves the tag of the registry image to a digest at plan time,
+// a new digest plans a redeployment of the container when deploy_on_digest_change is enabled.
+func customizeDiffContainerRegistryImageDigest(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("deploy_on_digest_change").(bool) {
+		return nil
+	}
+	if !diff.NewValueKnown("registry_image") {
+		return diff.SetNewComputed("registry_image_digest")
+	}
+
+	digest, err := resolveRegistryImageDigest(ctx, meta, diff.Get("registry_image").(string))
+	if err != nil {
+		return fmt.Errorf("failed to resolve the digest of registry_image: %w", err)
+	}
+
+	oldDigest, _ := diff.GetChange("registry_image_digest")
+	if oldDigest.(string) != digest {
+		return diff.SetNew("registry_image_digest", digest)
+	}
+
+	return nil
+}
@@ -247,3 +247,27 @@ func retryCreateFunctionDomain(ctx context.Context, functionAPI *function.API, r
 		}
 	}
 }
+
+// findFunctionNamespaceIDByName returns the ID of the Namespace with the given "<region>/<name>", it is used to import by name.
+func findFunctionNamespaceIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, region, name, err := functionAPIWithRegionAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListNamespaces(&function.ListNamespacesRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Namespaces, name, func(element *function.Namespace) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newRegionalIDString(region, id), nil
+}
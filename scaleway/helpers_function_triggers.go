@@ -19,7 +19,18 @@ func expandFunctionTriggerMnqSqsCreationConfig(i interface{}) *function.CreateTr
 	}
 }
 
-func completeFunctionTriggerMnqSqsCreationConfig(i interface{}, d *schema.ResourceData, meta interface{}, region scw.Region) error {
+func expandFunctionTriggerMnqNatsCreationConfig(i interface{}) *function.CreateTriggerRequestMnqNatsClientConfig {
+	m := i.(map[string]interface{})
+
+	return &function.CreateTriggerRequestMnqNatsClientConfig{
+		MnqNamespaceID: expandID(m["namespace_id"].(string)),
+		Subject:        m["subject"].(string),
+		MnqProjectID:   m["project_id"].(string),
+		MnqRegion:      m["region"].(string),
+	}
+}
+
+func completeFunctionTriggerMnqCreationConfig(i interface{}, d *schema.ResourceData, meta interface{}, region scw.Region) error {
 	m := i.(map[string]interface{})
 
 	if sqsRegion, exists := m["region"]; !exists || sqsRegion == "" {
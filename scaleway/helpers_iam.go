@@ -1,6 +1,13 @@
 package scaleway
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -86,3 +93,60 @@ func flattenPolicyRules(rules []*iam.Rule) interface{} {
 	}
 	return rawRules
 }
+
+// unknownPermissionSetNames returns the permission set names used in rules that are not part of permissionSets
+func unknownPermissionSetNames(permissionSets []*iam.PermissionSet, rules []*iam.RuleSpecs) []string {
+	knownNames := make(map[string]struct{}, len(permissionSets))
+	for _, permissionSet := range permissionSets {
+		knownNames[permissionSet.Name] = struct{}{}
+	}
+
+	unknownNames := map[string]struct{}{}
+	for _, rule := range rules {
+		if rule.PermissionSetNames == nil {
+			continue
+		}
+		for _, name := range *rule.PermissionSetNames {
+			if _, known := knownNames[name]; !known {
+				unknownNames[name] = struct{}{}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(unknownNames))
+	for name := range unknownNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// checkPolicyPermissionSetNames lists the permission sets available in the organization
+// and returns the names used in rules that do not exist
+func checkPolicyPermissionSetNames(ctx context.Context, api *iam.API, organizationID string, rules []*iam.RuleSpecs) ([]string, error) {
+	res, err := api.ListPermissionSets(&iam.ListPermissionSetsRequest{
+		OrganizationID: organizationID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return unknownPermissionSetNames(res.PermissionSets, rules), nil
+}
+
+// policyRulesError returns the diagnostics for an error returned when setting policy rules.
+// If some permission set names are unknown, the diagnostic will list them instead of the raw API error.
+func policyRulesError(ctx context.Context, api *iam.API, organizationID string, rules []*iam.RuleSpecs, err error) diag.Diagnostics {
+	unknownNames, listErr := checkPolicyPermissionSetNames(ctx, api, organizationID, rules)
+	if listErr != nil || len(unknownNames) == 0 {
+		return diagFromErr(err)
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("unknown permission set names: %s", strings.Join(unknownNames, ", ")),
+		Detail:        err.Error(),
+		AttributePath: cty.GetAttrPath("rule"),
+	}}
+}
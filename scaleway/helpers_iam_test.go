@@ -0,0 +1,59 @@
+package scaleway
+
+import (
+	"testing"
+
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupMembershipID(t *testing.T) {
+	groupID := "11111111-1111-1111-1111-111111111111"
+	memberID := "22222222-2222-2222-2222-222222222222"
+
+	userMembershipID := groupMembershipID(groupID, scw.StringPtr(memberID), nil)
+	assert.Equal(t, groupID+"/user/"+memberID, userMembershipID)
+
+	parsedGroupID, userID, applicationID, err := expandGroupMembershipID(userMembershipID)
+	require.NoError(t, err)
+	assert.Equal(t, groupID, parsedGroupID)
+	assert.Equal(t, memberID, userID)
+	assert.Empty(t, applicationID)
+
+	appMembershipID := groupMembershipID(groupID, nil, scw.StringPtr(memberID))
+	assert.Equal(t, groupID+"/app/"+memberID, appMembershipID)
+
+	parsedGroupID, userID, applicationID, err = expandGroupMembershipID(appMembershipID)
+	require.NoError(t, err)
+	assert.Equal(t, groupID, parsedGroupID)
+	assert.Empty(t, userID)
+	assert.Equal(t, memberID, applicationID)
+}
+
+func TestExpandGroupMembershipIDInvalid(t *testing.T) {
+	for _, id := range []string{
+		"11111111-1111-1111-1111-111111111111",
+		"11111111-1111-1111-1111-111111111111/user",
+		"11111111-1111-1111-1111-111111111111/group/22222222-2222-2222-2222-222222222222",
+	} {
+		_, _, _, err := expandGroupMembershipID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestUnknownPermissionSetNames(t *testing.T) {
+	permissionSets := []*iam.PermissionSet{
+		{Name: "AllProductsFullAccess"},
+		{Name: "InstancesReadOnly"},
+	}
+	rules := []*iam.RuleSpecs{
+		{PermissionSetNames: &[]string{"InstancesReadOnly", "InstanceReadOnly"}},
+		{PermissionSetNames: &[]string{"AllProductsFullAccess", "ObjectStorageFullAcess", "InstanceReadOnly"}},
+		{},
+	}
+
+	assert.Equal(t, []string{"InstanceReadOnly", "ObjectStorageFullAcess"}, unknownPermissionSetNames(permissionSets, rules))
+	assert.Empty(t, unknownPermissionSetNames(permissionSets, rules[:0]))
+}
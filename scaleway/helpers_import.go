@@ -0,0 +1,49 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/validation"
+)
+
+// findIDByNameFunc resolves a localized name ("<locality>/<name>") to a localized ID ("<locality>/<id>").
+type findIDByNameFunc func(ctx context.Context, m interface{}, localizedName string) (string, error)
+
+// importStateWithName returns an importer accepting "<locality>/<name>" in addition to "<locality>/<id>".
+func importStateWithName(findID findIDByNameFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		_, idOrName, err := parseLocalizedID(d.Id())
+		if err != nil || validation.IsUUID(idOrName) {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		id, err := findID(ctx, m, d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("cannot import %q: %w", d.Id(), err)
+		}
+		d.SetId(id)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// findIDByExactName returns the ID of the only element named name.
+// List endpoints filter names with a partial match so the exact name is checked here.
+func findIDByExactName[T any](elements []T, name string, nameAndID func(T) (string, string)) (string, error) {
+	foundID := ""
+	for _, element := range elements {
+		elementName, elementID := nameAndID(element)
+		if elementName != name {
+			continue
+		}
+		if foundID != "" {
+			return "", fmt.Errorf("more than one resource found with the name %q", name)
+		}
+		foundID = elementID
+	}
+	if foundID == "" {
+		return "", fmt.Errorf("no resource found with the name %q", name)
+	}
+	return foundID, nil
+}
@@ -0,0 +1,32 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindIDByExactName(t *testing.T) {
+	type named struct {
+		Name string
+		ID   string
+	}
+	nameAndID := func(n named) (string, string) { return n.Name, n.ID }
+	elements := []named{
+		{Name: "web", ID: "1"},
+		{Name: "web-2", ID: "2"},
+		{Name: "db", ID: "3"},
+		{Name: "db", ID: "4"},
+	}
+
+	id, err := findIDByExactName(elements, "web", nameAndID)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	_, err = findIDByExactName(elements, "db", nameAndID)
+	assert.ErrorContains(t, err, "more than one resource")
+
+	_, err = findIDByExactName(elements, "cache", nameAndID)
+	assert.ErrorContains(t, err, "no resource found")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"sort"
 	"strconv"
 	"strings"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -40,6 +42,10 @@ const (
 	defaultInstanceImageTimeout = 1 * time.Hour
 )
 
+// instanceVolumeTypeScratch is the local NVMe scratch storage provided by some GPU commercial types
+// TODO: use the SDK enum once it is generated
+const instanceVolumeTypeScratch = instance.VolumeVolumeType("scratch")
+
 // instanceAPIWithZone returns a new instance API and the zone for a Create request
 func instanceAPIWithZone(d *schema.ResourceData, m interface{}) (*instance.API, scw.Zone, error) {
 	meta := m.(*Meta)
@@ -134,7 +140,7 @@ func serverStateExpand(rawState string) (instance.ServerState, error) {
 	return apiState, nil
 }
 
-func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, serverID string, toState instance.ServerState) error {
+func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, serverID string, toState instance.ServerState, timeout time.Duration) error {
 	response, err := instanceAPI.GetServer(&instance.GetServerRequest{
 		Zone:     zone,
 		ServerID: serverID,
@@ -169,7 +175,8 @@ func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, s
 				Zone:          zone,
 				VolumeID:      volume.ID,
 				RetryInterval: DefaultWaitRetryInterval,
-			})
+				Timeout:       scw.TimeDurationPtr(timeout),
+			}, scw.WithContext(ctx))
 			if err != nil {
 				return err
 			}
@@ -181,9 +188,9 @@ func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, s
 			ServerID:      serverID,
 			Action:        a,
 			Zone:          zone,
-			Timeout:       scw.TimeDurationPtr(defaultInstanceServerWaitTimeout),
+			Timeout:       scw.TimeDurationPtr(timeout),
 			RetryInterval: DefaultWaitRetryInterval,
-		})
+		}, scw.WithContext(ctx))
 		if err != nil {
 			return err
 		}
@@ -191,11 +198,47 @@ func reachState(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, s
 	return nil
 }
 
-// getServerType is a util to get a instance.ServerType by its commercialType
-func getServerType(ctx context.Context, apiInstance *instance.API, zone scw.Zone, commercialType string) *instance.ServerType {
-	serverType, err := apiInstance.GetServerType(&instance.GetServerTypeRequest{
-		Zone: zone,
-		Name: commercialType,
+// stopInstancePlacementGroupServers stops the running servers of a placement group and returns their IDs
+func stopInstancePlacementGroupServers(ctx context.Context, instanceAPI *instance.API, zone scw.Zone, placementGroupID string, timeout time.Duration) ([]string, error) {
+	res, err := instanceAPI.GetPlacementGroupServers(&instance.GetPlacementGroupServersRequest{
+		Zone:             zone,
+		PlacementGroupID: placementGroupID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	stoppedServerIDs := []string(nil)
+	for _, pgServer := range res.Servers {
+		server, err := instanceAPI.GetServer(&instance.GetServerRequest{
+			Zone:     zone,
+			ServerID: pgServer.ID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return stoppedServerIDs, err
+		}
+		if server.Server.State != instance.ServerStateRunning {
+			continue
+		}
+
+		err = reachState(ctx, instanceAPI, zone, pgServer.ID, instance.ServerStateStopped, timeout)
+		if err != nil {
+			return stoppedServerIDs, err
+		}
+		stoppedServerIDs = append(stoppedServerIDs, pgServer.ID)
+	}
+
+	return stoppedServerIDs, nil
+}
+
+// getServerType is a util to get a instance.ServerType by its commercialType, server types are cached by zone
+func getServerType(ctx context.Context, m interface{}, apiInstance *instance.API, zone scw.Zone, commercialType string) *instance.ServerType {
+	key := fmt.Sprintf("server-type/%s/%s", zone, commercialType)
+	serverType, err := cachedLookup(m.(*Meta).lookupCache, key, func() (*instance.ServerType, error) {
+		return apiInstance.GetServerType(&instance.GetServerTypeRequest{
+			Zone: zone,
+			Name: commercialType,
+		})
 	})
 	if err != nil {
 		tflog.Warn(ctx, fmt.Sprintf("cannot get server types: %s", err))
@@ -209,8 +252,137 @@ func getServerType(ctx context.Context, apiInstance *instance.API, zone scw.Zone
 	return nil
 }
 
+// getInstanceServerPrivateIPs returns the flattened IPAM IPs of the private NICs of a server.
+func getInstanceServerPrivateIPs(ctx context.Context, m interface{}, zone scw.Zone, privateNICs []*instance.PrivateNIC) ([]map[string]interface{}, error) {
+	if len(privateNICs) == 0 {
+		return nil, nil
+	}
+
+	region, err := zone.Region()
+	if err != nil {
+		return nil, err
+	}
+
+	privateNetworkIDs := make(map[string]string, len(privateNICs))
+	resourceIDs := make([]string, 0, len(privateNICs))
+	for _, privateNIC := range privateNICs {
+		privateNetworkIDs[privateNIC.ID] = privateNIC.PrivateNetworkID
+		resourceIDs = append(resourceIDs, privateNIC.ID)
+	}
+
+	res, err := ipam.NewAPI(m.(*Meta).scwClient).ListIPs(&ipam.ListIPsRequest{
+		Region:       region,
+		ResourceType: ipam.ResourceTypeInstancePrivateNic,
+		ResourceIDs:  resourceIDs,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	privateIPs := make([]map[string]interface{}, 0, len(res.IPs))
+	for _, ip := range res.IPs {
+		privateNetworkID := ""
+		if ip.Resource != nil {
+			privateNetworkID = newRegionalIDString(region, privateNetworkIDs[ip.Resource.ID])
+		}
+		privateIPs = append(privateIPs, map[string]interface{}{
+			"id":                 newRegionalIDString(region, ip.ID),
+			"address":            ip.Address.IP.String(),
+			"private_network_id": privateNetworkID,
+		})
+	}
+
+	return privateIPs, nil
+}
+
+// isInstanceServerAdditionalVolume returns true if the volume is referenced in the additional_volume_ids of the server
+func isInstanceServerAdditionalVolume(d *schema.ResourceData, volumeID string) bool {
+	for _, rawVolumeID := range d.Get("additional_volume_ids").([]interface{}) {
+		if expandZonedID(rawVolumeID).ID == volumeID {
+			return true
+		}
+	}
+	return false
+}
+
+// instanceServerPublicIPv4 returns the public IPv4 of a server or nil if it only has IPv6 addresses.
+func instanceServerPublicIPv4(server *instance.Server) *instance.ServerIP {
+	if server.PublicIP != nil && server.PublicIP.Family != instance.ServerIPIPFamilyInet6 {
+		return server.PublicIP
+	}
+	for _, ip := range server.PublicIPs {
+		if ip.Family == instance.ServerIPIPFamilyInet {
+			return ip
+		}
+	}
+	return nil
+}
+
+// flattenInstanceServerPublicIPs flattens the public IPs of a server, falling back to its legacy public IP.
+func flattenInstanceServerPublicIPs(zone scw.Zone, server *instance.Server) []interface{} {
+	publicIPs := server.PublicIPs
+	if len(publicIPs) == 0 && server.PublicIP != nil {
+		publicIPs = []*instance.ServerIP{server.PublicIP}
+	}
+
+	flattenedIPs := []interface{}(nil)
+	for _, ip := range publicIPs {
+		gateway := ""
+		if ip.Gateway != nil {
+			gateway = ip.Gateway.String()
+		}
+		flattenedIPs = append(flattenedIPs, map[string]interface{}{
+			"id":      newZonedIDString(zone, ip.ID),
+			"address": ip.Address.String(),
+			"family":  ip.Family.String(),
+			"dynamic": ip.Dynamic,
+			"gateway": gateway,
+			"netmask": ip.Netmask,
+		})
+	}
+	return flattenedIPs
+}
+
+// instanceServerIPv6 returns the IPv6 of a server, either its legacy IPv6 or its first routed IPv6.
+func instanceServerIPv6(server *instance.Server) *instance.ServerIPv6 {
+	if server.IPv6 != nil {
+		return server.IPv6
+	}
+	for _, ip := range server.PublicIPs {
+		if ip.Family == instance.ServerIPIPFamilyInet6 {
+			return &instance.ServerIPv6{
+				Address: ip.Address,
+				Gateway: ip.Gateway,
+				Netmask: ip.Netmask,
+			}
+		}
+	}
+	return nil
+}
+
+// setInstanceServerBootVolume flags the additional volume with the given ID as the boot volume of the server.
+func setInstanceServerBootVolume(volumes map[string]*instance.VolumeServerTemplate, bootVolumeID string) error {
+	if bootVolumeID == "" {
+		return nil
+	}
+	bootVolumeID = expandID(bootVolumeID)
+
+	for key, volume := range volumes {
+		if key != "0" && volume.ID != nil && *volume.ID == bootVolumeID {
+			volume.Boot = scw.BoolPtr(true)
+			return nil
+		}
+	}
+	return fmt.Errorf("boot volume %s must be one of the additional volumes of the server", bootVolumeID)
+}
+
 // validateLocalVolumeSizes validates the total size of local volumes.
+// Scratch volumes are not part of the local volume constraints of the server type and are not counted.
 func validateLocalVolumeSizes(volumes map[string]*instance.VolumeServerTemplate, serverType *instance.ServerType, commercialType string) error {
+	if rootVolume := volumes["0"]; rootVolume != nil && rootVolume.VolumeType == instanceVolumeTypeScratch {
+		return fmt.Errorf("%s root volume cannot be a scratch volume", commercialType)
+	}
+
 	// Calculate local volume total size.
 	var localVolumeTotalSize scw.Size
 	for _, volume := range volumes {
@@ -326,7 +498,7 @@ func newPrivateNICHandler(api *instance.API, server string, zone scw.Zone) (*pri
 
 func (ph *privateNICsHandler) flatPrivateNICs() error {
 	privateNICsMap := make(map[string]*instance.PrivateNIC)
-	res, err := ph.instanceAPI.ListPrivateNICs(&instance.ListPrivateNICsRequest{Zone: ph.zone, ServerID: ph.serverID})
+	res, err := ph.instanceAPI.ListPrivateNICs(&instance.ListPrivateNICsRequest{Zone: ph.zone, ServerID: ph.serverID}, scw.WithAllPages())
 	if err != nil {
 		return err
 	}
@@ -624,3 +796,177 @@ func retryUpdateReverseDNS(ctx context.Context, instanceAPI *instance.API, req *
 		}
 	}
 }
+
+// isInstanceServerMoreRecent returns true if server was created after other.
+func isInstanceServerMoreRecent(server, other *instance.Server) bool {
+	if server.CreationDate == nil || other.CreationDate == nil {
+		return other.CreationDate == nil && server.CreationDate != nil
+	}
+	return server.CreationDate.After(*other.CreationDate)
+}
+
+// flattenInstanceServerBackupSnapshotIDs returns the zoned IDs of the snapshots of a backup image, root volume first.
+func flattenInstanceServerBackupSnapshotIDs(zone scw.Zone, image *instance.Image) []string {
+	snapshotIDs := []string(nil)
+	if image.RootVolume != nil {
+		snapshotIDs = append(snapshotIDs, newZonedIDString(zone, image.RootVolume.ID))
+	}
+
+	keys := make([]string, 0, len(image.ExtraVolumes))
+	for key := range image.ExtraVolumes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		snapshotIDs = append(snapshotIDs, newZonedIDString(zone, image.ExtraVolumes[key].ID))
+	}
+
+	return snapshotIDs
+}
+
+// evaluateInstanceSecurityGroupRules returns the action applied by a security group to a packet and the rule matching it.
+// Rules are evaluated in order, non-editable rules first; when no rule matches, the default policy applies and the rule is nil.
+func evaluateInstanceSecurityGroupRules(rules []*instance.SecurityGroupRule, defaultPolicy instance.SecurityGroupPolicy, direction instance.SecurityGroupRuleDirection, protocol instance.SecurityGroupRuleProtocol, port uint32, ip net.IP) (instance.SecurityGroupRuleAction, *instance.SecurityGroupRule) {
+	sortedRules := append([]*instance.SecurityGroupRule(nil), rules...)
+	sort.SliceStable(sortedRules, func(i, j int) bool {
+		if sortedRules[i].Editable != sortedRules[j].Editable {
+			return !sortedRules[i].Editable
+		}
+		return sortedRules[i].Position < sortedRules[j].Position
+	})
+
+	for _, rule := range sortedRules {
+		if rule.Direction != direction {
+			continue
+		}
+		if rule.Protocol != instance.SecurityGroupRuleProtocolANY && rule.Protocol != protocol {
+			continue
+		}
+		if rule.DestPortFrom != nil && protocol != instance.SecurityGroupRuleProtocolICMP {
+			portTo := *rule.DestPortFrom
+			if rule.DestPortTo != nil {
+				portTo = *rule.DestPortTo
+			}
+			if port < *rule.DestPortFrom || port > portTo {
+				continue
+			}
+		}
+		if rule.IPRange.IP != nil && !rule.IPRange.Contains(ip) {
+			continue
+		}
+		return rule.Action, rule
+	}
+
+	return instance.SecurityGroupRuleAction(defaultPolicy), nil
+}
+
+// importInstanceServer imports a server by ID or name along with its private NICs,
+// so an adopted server is read with the private networks it is attached to.
+func importInstanceServer(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	resources, err := importStateWithName(findInstanceServerIDByName)(ctx, d, m)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(m, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	region, err := zone.Region()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := instanceAPI.ListPrivateNICs(&instance.ListPrivateNICsRequest{
+		Zone:     zone,
+		ServerID: id,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("cannot import %q: %w", d.Id(), err)
+	}
+
+	privateNetworks := []map[string]interface{}(nil)
+	for _, privateNIC := range res.PrivateNics {
+		privateNetworks = append(privateNetworks, map[string]interface{}{
+			"pn_id": newRegionalIDString(region, privateNIC.PrivateNetworkID),
+		})
+	}
+	_ = d.Set("private_network", privateNetworks)
+	_ = d.Set("rebuild_on_image_change", false)
+	_ = d.Set("replace_on_type_change", false)
+
+	return resources, nil
+}
+
+// findInstanceServerIDByName returns the ID of the Server with the given "<zone>/<name>", it is used to import by name.
+func findInstanceServerIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, zone, name, err := instanceAPIWithZoneAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListServers(&instance.ListServersRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Servers, name, func(element *instance.Server) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newZonedIDString(zone, id), nil
+}
+
+// findInstanceVolumeIDByName returns the ID of the Volume with the given "<zone>/<name>", it is used to import by name.
+func findInstanceVolumeIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, zone, name, err := instanceAPIWithZoneAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListVolumes(&instance.ListVolumesRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Volumes, name, func(element *instance.Volume) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newZonedIDString(zone, id), nil
+}
+
+// findInstanceSecurityGroupIDByName returns the ID of the SecurityGroup with the given "<zone>/<name>", it is used to import by name.
+func findInstanceSecurityGroupIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, zone, name, err := instanceAPIWithZoneAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListSecurityGroups(&instance.ListSecurityGroupsRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.SecurityGroups, name, func(element *instance.SecurityGroup) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newZonedIDString(zone, id), nil
+}
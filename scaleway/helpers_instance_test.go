@@ -0,0 +1,50 @@
+package scaleway
+
+import (
+	"net"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateInstanceSecurityGroupRules(t *testing.T) {
+	ipRange := func(cidr string) scw.IPNet {
+		_, ipNet, _ := net.ParseCIDR(cidr)
+		return scw.IPNet{IPNet: *ipNet}
+	}
+	rules := []*instance.SecurityGroupRule{
+		{ID: "ssh-admin", Direction: instance.SecurityGroupRuleDirectionInbound, Protocol: instance.SecurityGroupRuleProtocolTCP, Action: instance.SecurityGroupRuleActionAccept, IPRange: ipRange("10.0.0.0/8"), DestPortFrom: scw.Uint32Ptr(22), Position: 2, Editable: true},
+		{ID: "ssh-drop", Direction: instance.SecurityGroupRuleDirectionInbound, Protocol: instance.SecurityGroupRuleProtocolTCP, Action: instance.SecurityGroupRuleActionDrop, IPRange: ipRange("0.0.0.0/0"), DestPortFrom: scw.Uint32Ptr(22), Position: 3, Editable: true},
+		{ID: "web", Direction: instance.SecurityGroupRuleDirectionInbound, Protocol: instance.SecurityGroupRuleProtocolTCP, Action: instance.SecurityGroupRuleActionAccept, IPRange: ipRange("0.0.0.0/0"), DestPortFrom: scw.Uint32Ptr(80), DestPortTo: scw.Uint32Ptr(443), Position: 1, Editable: true},
+		{ID: "smtp", Direction: instance.SecurityGroupRuleDirectionOutbound, Protocol: instance.SecurityGroupRuleProtocolTCP, Action: instance.SecurityGroupRuleActionDrop, IPRange: ipRange("0.0.0.0/0"), DestPortFrom: scw.Uint32Ptr(25), Position: 1, Editable: false},
+	}
+
+	tests := []struct {
+		name           string
+		direction      instance.SecurityGroupRuleDirection
+		protocol       instance.SecurityGroupRuleProtocol
+		port           uint32
+		ip             string
+		expectedAction instance.SecurityGroupRuleAction
+		expectedRuleID string
+	}{
+		{"ssh from admin network", instance.SecurityGroupRuleDirectionInbound, instance.SecurityGroupRuleProtocolTCP, 22, "10.1.2.3", instance.SecurityGroupRuleActionAccept, "ssh-admin"},
+		{"ssh from internet", instance.SecurityGroupRuleDirectionInbound, instance.SecurityGroupRuleProtocolTCP, 22, "51.15.1.1", instance.SecurityGroupRuleActionDrop, "ssh-drop"},
+		{"web port range", instance.SecurityGroupRuleDirectionInbound, instance.SecurityGroupRuleProtocolTCP, 443, "51.15.1.1", instance.SecurityGroupRuleActionAccept, "web"},
+		{"default policy", instance.SecurityGroupRuleDirectionInbound, instance.SecurityGroupRuleProtocolUDP, 53, "51.15.1.1", instance.SecurityGroupRuleActionDrop, ""},
+		{"outbound smtp", instance.SecurityGroupRuleDirectionOutbound, instance.SecurityGroupRuleProtocolTCP, 25, "51.15.1.1", instance.SecurityGroupRuleActionDrop, "smtp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, rule := evaluateInstanceSecurityGroupRules(rules, instance.SecurityGroupPolicyDrop, tt.direction, tt.protocol, tt.port, net.ParseIP(tt.ip))
+			assert.Equal(t, tt.expectedAction, action)
+			if tt.expectedRuleID == "" {
+				assert.Nil(t, rule)
+			} else {
+				assert.Equal(t, tt.expectedRuleID, rule.ID)
+			}
+		})
+	}
+}
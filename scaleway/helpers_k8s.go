@@ -3,10 +3,12 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -149,6 +151,72 @@ func waitK8SPoolReady(ctx context.Context, k8sAPI *k8s.API, region scw.Region, p
 	return pool, nil
 }
 
+// drainK8SPoolNodes removes the count newest nodes of a pool one by one.
+// Each node is drained from its workload by the API before its deletion, and its removal is awaited before the next one.
+func drainK8SPoolNodes(ctx context.Context, k8sAPI *k8s.API, pool *k8s.Pool, count int, timeout time.Duration) error {
+	res, err := k8sAPI.ListNodes(&k8s.ListNodesRequest{
+		Region:    pool.Region,
+		ClusterID: pool.ClusterID,
+		PoolID:    &pool.ID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	nodes := res.Nodes
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].CreatedAt == nil || nodes[j].CreatedAt == nil {
+			return nodes[j].CreatedAt == nil
+		}
+		return nodes[i].CreatedAt.After(*nodes[j].CreatedAt)
+	})
+	if count > len(nodes) {
+		count = len(nodes)
+	}
+
+	for _, node := range nodes[:count] {
+		_, err = k8sAPI.DeleteNode(&k8s.DeleteNodeRequest{
+			Region:    pool.Region,
+			NodeID:    node.ID,
+			SkipDrain: false,
+			Replace:   false,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) {
+				continue
+			}
+			return err
+		}
+
+		err = waitK8SNodeDeleted(ctx, k8sAPI, pool.Region, node.ID, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func waitK8SNodeDeleted(ctx context.Context, k8sAPI *k8s.API, region scw.Region, nodeID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		node, err := k8sAPI.GetNode(&k8s.GetNodeRequest{
+			Region: region,
+			NodeID: nodeID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+		if node.Status == k8s.NodeStatusDeleted {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("node %s has state %s, wants %s", nodeID, node.Status, k8s.NodeStatusDeleted))
+	})
+}
+
 // convert a list of nodes to a list of map
 func convertNodes(res *k8s.ListNodesResponse) []map[string]interface{} {
 	var result []map[string]interface{}
@@ -304,9 +372,50 @@ func migrateToPrivateNetworkCluster(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return err
 	}
-	_, err = waitK8SCluster(ctx, k8sAPI, region, clusterID, defaultK8SClusterTimeout)
+	_, err = waitK8SCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// findK8SClusterIDByName returns the ID of the Cluster with the given "<region>/<name>", it is used to import by name.
+func findK8SClusterIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, region, name, err := k8sAPIWithRegionAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListClusters(&k8s.ListClustersRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Clusters, name, func(element *k8s.Cluster) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newRegionalIDString(region, id), nil
+}
+
+// k8sClusterHasTags returns true if the cluster has all the given tags
+func k8sClusterHasTags(cluster *k8s.Cluster, tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, clusterTag := range cluster.Tags {
+			if clusterTag == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainK8SPoolNodes(t *testing.T) {
+	var deletedNodes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/k8s/v1/regions/fr-par/clusters/cluster/nodes":
+			assert.Equal(t, "pool", r.URL.Query().Get("pool_id"))
+			_, _ = w.Write([]byte(`{"nodes":[
+				{"id":"node-1","created_at":"2023-08-01T00:00:00Z"},
+				{"id":"node-3","created_at":"2023-08-03T00:00:00Z"},
+				{"id":"node-2","created_at":"2023-08-02T00:00:00Z"}
+			],"total_count":3}`))
+		case r.Method == http.MethodDelete:
+			assert.Equal(t, "false", r.URL.Query().Get("skip_drain"))
+			deletedNodes = append(deletedNodes, path.Base(r.URL.Path))
+			_, _ = w.Write([]byte(`{"status":"deleting"}`))
+		case r.Method == http.MethodGet:
+			// Deleted nodes are not found anymore
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"type":"not_found","message":"node not found"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL))
+	require.NoError(t, err)
+
+	pool := &k8s.Pool{ID: "pool", ClusterID: "cluster", Region: scw.RegionFrPar}
+	err = drainK8SPoolNodes(context.Background(), k8s.NewAPI(client), pool, 2, time.Minute)
+	require.NoError(t, err)
+	// The newest nodes are removed one by one
+	assert.Equal(t, []string{"node-3", "node-2"}, deletedNodes)
+}
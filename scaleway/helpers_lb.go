@@ -6,14 +6,15 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	validator "github.com/scaleway/scaleway-sdk-go/validation"
@@ -206,7 +207,7 @@ func flattenPrivateNetworkConfigs(privateNetworks []*lbSDK.PrivateNetwork) inter
 		}
 		pnRegion, err := pn.LB.Zone.Region()
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		pnRegionalID := newRegionalIDString(pnRegion, pn.PrivateNetworkID)
 		pnI = append(pnI, map[string]interface{}{
@@ -629,3 +630,119 @@ func lbPrivateNetworkSetHash(v interface{}) int {
 
 	return StringHashcode(buf.String())
 }
+
+// lbBackendServerIPsFromTags returns the sorted IPs of the instance servers of the zone having the given tags.
+// Private IPs are the IPAM IPs of the private NICs of the servers.
+func lbBackendServerIPsFromTags(ctx context.Context, m interface{}, zone scw.Zone, tags []string, ipType string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	instanceAPI := instance.NewAPI(m.(*Meta).scwClient)
+	res, err := instanceAPI.ListServers(&instance.ListServersRequest{
+		Zone: zone,
+		Tags: tags,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ips := []string(nil)
+	privateNICs := []*instance.PrivateNIC(nil)
+	for _, server := range res.Servers {
+		switch ipType {
+		case "public":
+			if server.PublicIP != nil {
+				ips = append(ips, server.PublicIP.Address.String())
+			}
+		default:
+			privateNICs = append(privateNICs, server.PrivateNics...)
+		}
+	}
+
+	privateIPs, err := getInstanceServerPrivateIPs(ctx, m, zone, privateNICs)
+	if err != nil {
+		return nil, err
+	}
+	for _, privateIP := range privateIPs {
+		ips = append(ips, privateIP["address"].(string))
+	}
+	sort.Strings(ips)
+
+	return ips, nil
+}
+
+// mergeLbBackendServerIPs returns the static server IPs followed by the dynamic ones that are not already listed.
+func mergeLbBackendServerIPs(staticIPs []string, dynamicIPs []string) []string {
+	ips := append([]string(nil), staticIPs...)
+	for _, ip := range dynamicIPs {
+		if !sliceContainsString(ips, ip) {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// lbBackendStaticServerIPs returns the IPs of the backend pool that were not resolved from server tags.
+// IPs both listed statically and resolved dynamically are kept.
+func lbBackendStaticServerIPs(pool []string, staticIPs []string, dynamicIPs []string) []string {
+	ips := []string(nil)
+	for _, ip := range pool {
+		if sliceContainsString(staticIPs, ip) || !sliceContainsString(dynamicIPs, ip) {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// customizeDiffLbBackendServerTags plans a change of the backend servers when the instance servers having server_tags changed.
+func customizeDiffLbBackendServerTags(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.HasChanges("server_tags", "server_tags_ip_type") {
+		return diff.SetNewComputed("dynamic_server_ips")
+	}
+
+	tags := expandStrings(diff.Get("server_tags"))
+	if diff.Id() == "" || len(tags) == 0 || diff.HasChange("lb_id") {
+		return nil
+	}
+
+	zone, _, err := parseZonedID(diff.Get("lb_id").(string))
+	if err != nil {
+		return err
+	}
+
+	ips, err := lbBackendServerIPsFromTags(ctx, meta, zone, tags, diff.Get("server_tags_ip_type").(string))
+	if err != nil {
+		return err
+	}
+
+	if strings.Join(ips, ",") != strings.Join(expandStrings(diff.Get("dynamic_server_ips")), ",") {
+		return diff.SetNew("dynamic_server_ips", ips)
+	}
+
+	return nil
+}
+
+// findLbIDByName returns the ID of the LB with the given "<zone>/<name>", it is used to import by name.
+func findLbIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, zone, name, err := lbAPIWithZoneAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListLBs(&lbSDK.ZonedAPIListLBsRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.LBs, name, func(element *lbSDK.LB) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newZonedIDString(zone, id), nil
+}
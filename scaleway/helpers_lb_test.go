@@ -1,11 +1,15 @@
 package scaleway
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsEqualPrivateNetwork(t *testing.T) {
@@ -52,3 +56,55 @@ func TestIsEqualPrivateNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestLbBackendServerIPs(t *testing.T) {
+	merged := mergeLbBackendServerIPs([]string{"10.0.0.1", "10.0.0.2"}, []string{"10.0.0.2", "10.0.0.3"})
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, merged)
+
+	static := lbBackendStaticServerIPs(merged, []string{"10.0.0.1", "10.0.0.2"}, []string{"10.0.0.2", "10.0.0.3"})
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, static)
+
+	imported := lbBackendStaticServerIPs(merged, nil, nil)
+	assert.Equal(t, merged, imported)
+}
+
+func TestLbBackendServerIPsFromTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/instance/v1/zones/fr-par-1/servers":
+			assert.Equal(t, "backend", r.URL.Query().Get("tags"))
+			_, _ = w.Write([]byte(`{"servers":[
+				{"id":"server-1","public_ip":{"address":"51.15.0.2"},"private_ip":"10.64.0.2","private_nics":[{"id":"nic-1","private_network_id":"pn-1"}]},
+				{"id":"server-2","public_ip":{"address":"51.15.0.1"},"private_ip":"10.64.0.1","private_nics":[{"id":"nic-2","private_network_id":"pn-1"}]},
+				{"id":"server-3","private_ip":"10.64.0.3","private_nics":[]}
+			]}`))
+		case "/ipam/v1alpha1/regions/fr-par/ips":
+			// The IPs of all the private NICs are listed at once
+			assert.Equal(t, []string{"nic-1", "nic-2"}, r.URL.Query()["resource_ids"])
+			_, _ = w.Write([]byte(`{"ips":[
+				{"id":"ip-1","address":"172.16.0.3/22","resource":{"id":"nic-1","type":"instance_private_nic"}},
+				{"id":"ip-2","address":"172.16.0.2/22","resource":{"id":"nic-2","type":"instance_private_nic"}}
+			],"total_count":2}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL))
+	require.NoError(t, err)
+	meta := &Meta{scwClient: client}
+
+	ips, err := lbBackendServerIPsFromTags(context.Background(), meta, scw.ZoneFrPar1, []string{"backend"}, "private")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"172.16.0.2", "172.16.0.3"}, ips)
+
+	ips, err = lbBackendServerIPsFromTags(context.Background(), meta, scw.ZoneFrPar1, []string{"backend"}, "public")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"51.15.0.1", "51.15.0.2"}, ips)
+
+	ips, err = lbBackendServerIPsFromTags(context.Background(), meta, scw.ZoneFrPar1, nil, "private")
+	require.NoError(t, err)
+	assert.Empty(t, ips)
+}
@@ -0,0 +1,108 @@
+package scaleway
+
+import (
+	"fmt"
+	"sort"
+
+	applesilicon "github.com/scaleway/scaleway-sdk-go/api/applesilicon/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	container "github.com/scaleway/scaleway-sdk-go/api/container/v1beta1"
+	flexibleip "github.com/scaleway/scaleway-sdk-go/api/flexibleip/v1alpha1"
+	function "github.com/scaleway/scaleway-sdk-go/api/function/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/iot/v1"
+	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/api/k8s/v1"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	mnq "github.com/scaleway/scaleway-sdk-go/api/mnq/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/redis/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/registry/v1"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1alpha1"
+	tem "github.com/scaleway/scaleway-sdk-go/api/tem/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v1"
+	vpcV2 "github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	vpcgw "github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	webhosting "github.com/scaleway/scaleway-sdk-go/api/webhosting/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// productRegions lists the regions where each regional product is available
+var productRegions = map[string]func() []scw.Region{
+	"container":  container.NewAPI(nil).Regions,
+	"function":   function.NewAPI(nil).Regions,
+	"iot":        iot.NewAPI(nil).Regions,
+	"ipam":       ipam.NewAPI(nil).Regions,
+	"k8s":        k8s.NewAPI(nil).Regions,
+	"lb":         lbSDK.NewAPI(nil).Regions,
+	"mnq":        mnq.NewAPI(nil).Regions,
+	"rdb":        rdb.NewAPI(nil).Regions,
+	"registry":   registry.NewAPI(nil).Regions,
+	"secret":     secret.NewAPI(nil).Regions,
+	"tem":        tem.NewAPI(nil).Regions,
+	"vpc":        vpcV2.NewAPI(nil).Regions,
+	"webhosting": webhosting.NewAPI(nil).Regions,
+}
+
+// productZones lists the zones where each zonal product is available
+var productZones = map[string]func() []scw.Zone{
+	"apple_silicon": applesilicon.NewAPI(nil).Zones,
+	"baremetal":     baremetal.NewAPI(nil).Zones,
+	"flexible_ip":   flexibleip.NewAPI(nil).Zones,
+	"instance":      instance.NewAPI(nil).Zones,
+	"lb":            lbSDK.NewZonedAPI(nil).Zones,
+	"redis":         redis.NewAPI(nil).Zones,
+	"vpc":           vpc.NewAPI(nil).Zones,
+	"vpcgw":         vpcgw.NewAPI(nil).Zones,
+}
+
+// sortedProductNames returns the sorted product names of a product locality map
+func sortedProductNames[T any](products map[string]T) []string {
+	names := make([]string, 0, len(products))
+	for name := range products {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// regionsForProduct returns the regions where the product is available, or all regions if product is empty
+func regionsForProduct(product string) ([]scw.Region, error) {
+	if product == "" {
+		return scw.AllRegions, nil
+	}
+
+	regions, ok := productRegions[product]
+	if !ok {
+		return nil, fmt.Errorf("unknown regional product %s, expected one of %v", product, sortedProductNames(productRegions))
+	}
+
+	return regions(), nil
+}
+
+// zonesForProduct returns the zones of the region where the product is available, or all zones of the region if product is empty
+func zonesForProduct(region scw.Region, product string) ([]scw.Zone, error) {
+	if product == "" {
+		return region.GetZones(), nil
+	}
+
+	zones, ok := productZones[product]
+	if !ok {
+		return nil, fmt.Errorf("unknown zonal product %s, expected one of %v", product, sortedProductNames(productZones))
+	}
+
+	available := make(map[scw.Zone]bool)
+	for _, zone := range zones() {
+		available[zone] = true
+	}
+
+	productZonesInRegion := []scw.Zone(nil)
+	for _, zone := range region.GetZones() {
+		if available[zone] {
+			productZonesInRegion = append(productZonesInRegion, zone)
+		}
+	}
+
+	return productZonesInRegion, nil
+}
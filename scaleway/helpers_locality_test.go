@@ -0,0 +1,40 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionsForProduct(t *testing.T) {
+	regions, err := regionsForProduct("")
+	require.NoError(t, err)
+	assert.Equal(t, scw.AllRegions, regions)
+
+	regions, err = regionsForProduct("rdb")
+	require.NoError(t, err)
+	assert.NotEmpty(t, regions)
+
+	_, err = regionsForProduct("unknown")
+	assert.Error(t, err)
+}
+
+func TestZonesForProduct(t *testing.T) {
+	zones, err := zonesForProduct(scw.RegionFrPar, "")
+	require.NoError(t, err)
+	assert.Equal(t, scw.RegionFrPar.GetZones(), zones)
+
+	zones, err = zonesForProduct(scw.RegionFrPar, "instance")
+	require.NoError(t, err)
+	assert.Contains(t, zones, scw.ZoneFrPar1)
+	for _, zone := range zones {
+		region, err := zone.Region()
+		require.NoError(t, err)
+		assert.Equal(t, scw.RegionFrPar, region)
+	}
+
+	_, err = zonesForProduct(scw.RegionFrPar, "unknown")
+	assert.Error(t, err)
+}
@@ -1,6 +1,9 @@
 package scaleway
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -17,3 +20,17 @@ func marketplaceAPIWithZone(d *schema.ResourceData, m interface{}) (*marketplace
 	}
 	return marketplaceAPI, zone, nil
 }
+
+// getMarketplaceLocalImageByLabel returns the instance local image of a marketplace label for a commercial type,
+// images are cached by zone, commercial type and label
+func getMarketplaceLocalImageByLabel(ctx context.Context, m interface{}, marketplaceAPI *marketplace.API, zone scw.Zone, commercialType string, label string) (*marketplace.LocalImage, error) {
+	key := fmt.Sprintf("marketplace-image/%s/%s/%s", zone, commercialType, label)
+	return cachedLookup(m.(*Meta).lookupCache, key, func() (*marketplace.LocalImage, error) {
+		return marketplaceAPI.GetLocalImageByLabel(&marketplace.GetLocalImageByLabelRequest{
+			CommercialType: commercialType,
+			Zone:           zone,
+			ImageLabel:     label,
+			Type:           marketplace.LocalImageTypeInstanceLocal,
+		}, scw.WithContext(ctx))
+	})
+}
@@ -129,6 +129,7 @@ var SQSAttributesToResourceMap = map[string]string{
 	sqs.QueueAttributeNameContentBasedDeduplication:     "sqs.0.content_based_deduplication",
 	sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds: "sqs.0.receive_wait_time_seconds",
 	sqs.QueueAttributeNameVisibilityTimeout:             "sqs.0.visibility_timeout_seconds",
+	sqs.QueueAttributeNameRedrivePolicy:                 "sqs.0.redrive_policy",
 }
 
 // Returns all managed SQS attribute names
@@ -138,6 +139,7 @@ func getSQSAttributeNames() []*string {
 	for attribute := range SQSAttributesToResourceMap {
 		attributeNames = append(attributeNames, aws.String(attribute))
 	}
+	attributeNames = append(attributeNames, aws.String(sqs.QueueAttributeNameQueueArn))
 
 	return attributeNames
 }
@@ -29,13 +29,20 @@ const (
 	defaultObjectBucketTimeout = 10 * time.Minute
 
 	maxObjectVersionDeletionWorkers = 8
+
+	defaultObjectEndpoint = "https://s3.{region}.scw.cloud"
+	scwS3EndpointEnv      = "SCW_S3_ENDPOINT"
 )
 
-func newS3Client(httpClient *http.Client, region, accessKey, secretKey string) (*s3.S3, error) {
+func newS3Client(httpClient *http.Client, endpoint, region, accessKey, secretKey string) (*s3.S3, error) {
+	if endpoint == "" {
+		endpoint = defaultObjectEndpoint
+	}
+
 	config := &aws.Config{}
 	config.WithRegion(region)
 	config.WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, ""))
-	config.WithEndpoint("https://s3." + region + ".scw.cloud")
+	config.WithEndpoint(strings.ReplaceAll(endpoint, "{region}", region))
 	config.WithHTTPClient(httpClient)
 	if logging.IsDebugOrHigher() {
 		config.WithLogLevel(aws.LogDebugWithHTTPBody)
@@ -50,15 +57,14 @@ func newS3Client(httpClient *http.Client, region, accessKey, secretKey string) (
 
 func newS3ClientFromMeta(meta *Meta) (*s3.S3, error) {
 	region, _ := meta.scwClient.GetDefaultRegion()
-	accessKey, _ := meta.scwClient.GetAccessKey()
-	secretKey, _ := meta.scwClient.GetSecretKey()
+	accessKey, secretKey := s3Credentials(meta)
 
 	projectID, _ := meta.scwClient.GetDefaultProjectID()
 	if projectID != "" {
 		accessKey = accessKeyWithProjectID(accessKey, projectID)
 	}
 
-	return newS3Client(meta.httpClient, region.String(), accessKey, secretKey)
+	return newS3Client(meta.httpClient, meta.s3Endpoint, region.String(), accessKey, secretKey)
 }
 
 func s3ClientWithRegion(d *schema.ResourceData, m interface{}) (*s3.S3, scw.Region, error) {
@@ -68,13 +74,12 @@ func s3ClientWithRegion(d *schema.ResourceData, m interface{}) (*s3.S3, scw.Regi
 		return nil, "", err
 	}
 
-	accessKey, _ := meta.scwClient.GetAccessKey()
+	accessKey, secretKey := s3Credentials(meta)
 	if projectID, _, err := extractProjectID(d, meta); err == nil {
 		accessKey = accessKeyWithProjectID(accessKey, projectID)
 	}
-	secretKey, _ := meta.scwClient.GetSecretKey()
 
-	s3Client, err := newS3Client(meta.httpClient, region.String(), accessKey, secretKey)
+	s3Client, err := newS3Client(meta.httpClient, meta.s3Endpoint, region.String(), accessKey, secretKey)
 	if err != nil {
 		return nil, "", err
 	}
@@ -97,8 +102,7 @@ func s3ClientWithRegionAndName(d *schema.ResourceData, m interface{}, id string)
 
 	d.SetId(fmt.Sprintf("%s/%s", region, name))
 
-	accessKey, _ := meta.scwClient.GetAccessKey()
-	secretKey, _ := meta.scwClient.GetSecretKey()
+	accessKey, secretKey := s3Credentials(meta)
 
 	if len(parts) == 2 {
 		accessKey = accessKeyWithProjectID(accessKey, parts[1])
@@ -109,7 +113,7 @@ func s3ClientWithRegionAndName(d *schema.ResourceData, m interface{}, id string)
 		}
 	}
 
-	s3Client, err := newS3Client(meta.httpClient, region.String(), accessKey, secretKey)
+	s3Client, err := newS3Client(meta.httpClient, meta.s3Endpoint, region.String(), accessKey, secretKey)
 	if err != nil {
 		return nil, "", "", err
 	}
@@ -124,13 +128,12 @@ func s3ClientWithRegionAndNestedName(d *schema.ResourceData, m interface{}, name
 		return nil, "", "", "", err
 	}
 
-	accessKey, _ := meta.scwClient.GetAccessKey()
+	accessKey, secretKey := s3Credentials(meta)
 	if projectID, _, err := extractProjectID(d, meta); err == nil {
 		accessKey = accessKeyWithProjectID(accessKey, projectID)
 	}
-	secretKey, _ := meta.scwClient.GetSecretKey()
 
-	s3Client, err := newS3Client(meta.httpClient, region.String(), accessKey, secretKey)
+	s3Client, err := newS3Client(meta.httpClient, meta.s3Endpoint, region.String(), accessKey, secretKey)
 	if err != nil {
 		return nil, "", "", "", err
 	}
@@ -145,19 +148,33 @@ func s3ClientWithRegionWithNameACL(d *schema.ResourceData, m interface{}, name s
 		return nil, "", name, "", err
 	}
 
-	accessKey, _ := meta.scwClient.GetAccessKey()
+	accessKey, secretKey := s3Credentials(meta)
 	if projectID, _, err := extractProjectID(d, meta); err == nil {
 		accessKey = accessKeyWithProjectID(accessKey, projectID)
 	}
-	secretKey, _ := meta.scwClient.GetSecretKey()
 
-	s3Client, err := newS3Client(meta.httpClient, region, accessKey, secretKey)
+	s3Client, err := newS3Client(meta.httpClient, meta.s3Endpoint, region, accessKey, secretKey)
 	if err != nil {
 		return nil, "", "", "", err
 	}
 	return s3Client, scw.Region(region), name, outerID, err
 }
 
+// s3Credentials returns the keys used to sign object storage requests,
+// the provider keys unless they are overridden in the object_storage block.
+func s3Credentials(meta *Meta) (accessKey string, secretKey string) {
+	accessKey, _ = meta.scwClient.GetAccessKey()
+	secretKey, _ = meta.scwClient.GetSecretKey()
+	if meta.s3AccessKey != "" {
+		accessKey = meta.s3AccessKey
+	}
+	if meta.s3SecretKey != "" {
+		secretKey = meta.s3SecretKey
+	}
+
+	return accessKey, secretKey
+}
+
 func accessKeyWithProjectID(accessKey string, projectID string) string {
 	return accessKey + "@" + projectID
 }
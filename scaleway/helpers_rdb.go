@@ -2,22 +2,34 @@ package scaleway
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 const (
 	defaultRdbInstanceTimeout   = 15 * time.Minute
 	defaultWaitRDBRetryInterval = 30 * time.Second
+
+	rdbUserGeneratedPasswordLength = 32
 )
 
+// rdbUserPasswordCharsets are the character classes a generated database user password contains at least once.
+var rdbUserPasswordCharsets = []string{
+	"abcdefghijklmnopqrstuvwxyz",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"0123456789",
+	"!#$%&*+-.:=?@_~",
+}
+
 // newRdbAPI returns a new RDB API
 func newRdbAPI(m interface{}) *rdb.API {
 	meta := m.(*Meta)
@@ -154,7 +166,7 @@ func flattenPrivateNetwork(endpoints []*rdb.Endpoint) (interface{}, bool) {
 			pn := endpoint.PrivateNetwork
 			fetchRegion, err := pn.Zone.Region()
 			if err != nil {
-				return diag.FromErr(err), false
+				return diagFromErr(err), false
 			}
 			pnRegionalID := newRegionalIDString(fetchRegion, pn.PrivateNetworkID)
 			serviceIP, err := flattenIPNet(pn.ServiceIP)
@@ -195,6 +207,66 @@ func flattenLoadBalancer(endpoints []*rdb.Endpoint) interface{} {
 	return flat
 }
 
+func flattenRdbInstanceMaintenances(maintenances []*rdb.Maintenance) interface{} {
+	flat := []map[string]interface{}(nil)
+	for _, maintenance := range maintenances {
+		flat = append(flat, map[string]interface{}{
+			"starts_at": flattenTime(maintenance.StartsAt),
+			"stops_at":  flattenTime(maintenance.StopsAt),
+			"closed_at": flattenTime(maintenance.ClosedAt),
+			"reason":    maintenance.Reason,
+			"status":    maintenance.Status.String(),
+		})
+	}
+
+	return flat
+}
+
+func expandRdbInstanceLogsPolicy(i interface{}) *rdb.LogsPolicy {
+	rawPolicies := i.([]interface{})
+	if len(rawPolicies) == 0 || rawPolicies[0] == nil {
+		return nil
+	}
+	rawPolicy := rawPolicies[0].(map[string]interface{})
+
+	policy := &rdb.LogsPolicy{}
+	if maxAge := rawPolicy["max_age_retention"].(int); maxAge > 0 {
+		policy.MaxAgeRetention = scw.Uint32Ptr(uint32(maxAge))
+	}
+	if totalDisk := rawPolicy["total_disk_retention"].(int); totalDisk > 0 {
+		policy.TotalDiskRetention = scw.SizePtr(scw.Size(totalDisk))
+	}
+
+	return policy
+}
+
+func flattenRdbInstanceLogsPolicy(policy *rdb.LogsPolicy) interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	flat := map[string]interface{}{}
+	if policy.MaxAgeRetention != nil {
+		flat["max_age_retention"] = int(*policy.MaxAgeRetention)
+	}
+	if policy.TotalDiskRetention != nil {
+		flat["total_disk_retention"] = int(*policy.TotalDiskRetention)
+	}
+
+	return []map[string]interface{}{flat}
+}
+
+func flattenRdbInstanceLog(log *rdb.InstanceLog) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           newRegionalIDString(log.Region, log.ID),
+		"node_name":    log.NodeName,
+		"status":       log.Status.String(),
+		"download_url": flattenStringPtr(log.DownloadURL),
+		"created_at":   flattenTime(log.CreatedAt),
+		"expires_at":   flattenTime(log.ExpiresAt),
+	}
+}
+
 // expandTimePtr returns a time pointer for an RFC3339 time.
 // It returns nil if time is not valid, you should use validateDate to validate field.
 func expandTimePtr(i interface{}) *time.Time {
@@ -264,7 +336,7 @@ func flattenReadReplicaEndpoints(endpoints []*rdb.Endpoint) (directAccess, priva
 		if endpoint.PrivateNetwork != nil {
 			fetchRegion, err := endpoint.PrivateNetwork.Zone.Region()
 			if err != nil {
-				return diag.FromErr(err), false
+				return diagFromErr(err), false
 			}
 			pnRegionalID := newRegionalIDString(fetchRegion, endpoint.PrivateNetwork.PrivateNetworkID)
 			rawEndpoint["private_network_id"] = pnRegionalID
@@ -321,3 +393,86 @@ func rdbPrivilegeUpgradeV1SchemaType() cty.Type {
 		"id": cty.String,
 	})
 }
+
+// findRdbInstanceIDByName returns the ID of the Instance with the given "<region>/<name>", it is used to import by name.
+func findRdbInstanceIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, region, name, err := rdbAPIWithRegionAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListInstances(&rdb.ListInstancesRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Instances, name, func(element *rdb.Instance) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newRegionalIDString(region, id), nil
+}
+
+// generateRdbUserPassword returns a random password containing at least one character of each rdbUserPasswordCharsets.
+func generateRdbUserPassword() (string, error) {
+	randomChar := func(charset string) (byte, error) {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(charset))))
+		if err != nil {
+			return 0, err
+		}
+		return charset[n.Int64()], nil
+	}
+
+	password := make([]byte, 0, rdbUserGeneratedPasswordLength)
+	for _, charset := range rdbUserPasswordCharsets {
+		c, err := randomChar(charset)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	allChars := strings.Join(rdbUserPasswordCharsets, "")
+	for len(password) < rdbUserGeneratedPasswordLength {
+		c, err := randomChar(allChars)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// shuffle so the mandatory characters are not always first
+	for i := len(password) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		j := n.Int64()
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// storeRdbUserPasswordInSecret creates a new version of the given secret containing the password, disabling the previous one.
+func storeRdbUserPasswordInSecret(ctx context.Context, m interface{}, secretID string, userName string, password string) error {
+	api, region, id, err := secretAPIWithRegionAndID(m, secretID)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.CreateSecretVersion(&secret.CreateSecretVersionRequest{
+		Region:          region,
+		SecretID:        id,
+		Data:            []byte(password),
+		Description:     scw.StringPtr(fmt.Sprintf("password of database user %s", userName)),
+		DisablePrevious: scw.BoolPtr(true),
+	}, scw.WithContext(ctx))
+
+	return err
+}
@@ -3,7 +3,11 @@ package scaleway
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRDBPrivilegeV1SchemaUpgradeFunc(t *testing.T) {
@@ -29,3 +33,16 @@ func TestRDBPrivilegeV1SchemaUpgradeFunc(t *testing.T) {
 		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", v1Schema, actual)
 	}
 }
+
+func TestGenerateRdbUserPassword(t *testing.T) {
+	password, err := generateRdbUserPassword()
+	require.NoError(t, err)
+	assert.Len(t, password, rdbUserGeneratedPasswordLength)
+	for _, charset := range rdbUserPasswordCharsets {
+		assert.True(t, strings.ContainsAny(password, charset), "password %q must contain one of %q", password, charset)
+	}
+
+	otherPassword, err := generateRdbUserPassword()
+	require.NoError(t, err)
+	assert.NotEqual(t, password, otherPassword)
+}
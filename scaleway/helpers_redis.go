@@ -7,7 +7,6 @@ import (
 	"sort"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/redis/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -151,7 +150,7 @@ func flattenRedisPrivateNetwork(endpoints []*redis.Endpoint) (interface{}, bool)
 		pn := endpoint.PrivateNetwork
 		fetchRegion, err := pn.Zone.Region()
 		if err != nil {
-			return diag.FromErr(err), false
+			return diagFromErr(err), false
 		}
 		pnRegionalID := newRegionalIDString(fetchRegion, pn.ID)
 		serviceIps := []interface{}(nil)
@@ -210,3 +209,27 @@ func redisPrivateNetworkSetHash(v interface{}) int {
 
 	return StringHashcode(buf.String())
 }
+
+// findRedisClusterIDByName returns the ID of the Cluster with the given "<zone>/<name>", it is used to import by name.
+func findRedisClusterIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, zone, name, err := redisAPIWithZoneAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListClusters(&redis.ListClustersRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Clusters, name, func(element *redis.Cluster) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newZonedIDString(zone, id), nil
+}
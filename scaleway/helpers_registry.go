@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -98,3 +99,122 @@ func waitForRegistryNamespaceDelete(ctx context.Context, api *registry.API, regi
 		}
 	}
 }
+
+// findRegistryNamespaceIDByName returns the ID of the Namespace with the given "<region>/<name>", it is used to import by name.
+func findRegistryNamespaceIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, region, name, err := registryAPIWithRegionAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListNamespaces(&registry.ListNamespacesRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Namespaces, name, func(element *registry.Namespace) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newRegionalIDString(region, id), nil
+}
+
+// parseRegistryImage splits a Scaleway registry image address ("rg.<region>.scw.cloud/<namespace>/<image>[:<tag>][@<digest>]")
+// into its region, namespace, image name, tag and digest. The tag defaults to "latest" and is ignored when a digest is set.
+func parseRegistryImage(image string) (region scw.Region, namespace string, name string, tag string, digest string, err error) {
+	parts := strings.SplitN(image, "/", 3)
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], "rg.") || !strings.HasSuffix(parts[0], ".scw.cloud") {
+		return "", "", "", "", "", fmt.Errorf("image %q is not hosted on a Scaleway registry", image)
+	}
+
+	region, err = scw.ParseRegion(strings.TrimSuffix(strings.TrimPrefix(parts[0], "rg."), ".scw.cloud"))
+	if err != nil {
+		return "", "", "", "", "", err
+	}
+
+	name = parts[2]
+	if i := strings.Index(name, "@"); i != -1 {
+		name, digest = name[:i], name[i+1:]
+		if !strings.HasPrefix(digest, "sha256:") || len(digest) == len("sha256:") {
+			return "", "", "", "", "", fmt.Errorf("image %q has an invalid digest, expected sha256:<hex>", image)
+		}
+	}
+
+	tag = "latest"
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		name, tag = name[:i], name[i+1:]
+	}
+	if digest != "" {
+		tag = ""
+	}
+
+	return region, parts[1], name, tag, digest, nil
+}
+
+// resolveRegistryImageDigest returns the digest currently pointed by the tag of a Scaleway registry image,
+// or the digest of the image address itself when it is pinned with "@sha256:".
+func resolveRegistryImageDigest(ctx context.Context, m interface{}, image string) (string, error) {
+	region, namespaceName, imageName, tagName, digest, err := parseRegistryImage(image)
+	if err != nil {
+		return "", err
+	}
+	if digest != "" {
+		return digest, nil
+	}
+
+	meta := m.(*Meta)
+	api := registry.NewAPI(meta.scwClient)
+
+	namespaces, err := api.ListNamespaces(&registry.ListNamespacesRequest{
+		Region: region,
+		Name:   &namespaceName,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	namespaceID, err := findIDByExactName(namespaces.Namespaces, namespaceName, func(element *registry.Namespace) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+
+	images, err := api.ListImages(&registry.ListImagesRequest{
+		Region:      region,
+		NamespaceID: &namespaceID,
+		Name:        &imageName,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	// The name filter matches images with a name like it, only the exact one is kept
+	imageID, err := findIDByExactName(images.Images, imageName, func(element *registry.Image) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", fmt.Errorf("image %s in namespace %s: %w", imageName, namespaceName, err)
+	}
+
+	tags, err := api.ListTags(&registry.ListTagsRequest{
+		Region:  region,
+		ImageID: imageID,
+		Name:    &tagName,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	// The digest of the tag with the exact name is returned in place of its ID
+	digest, err = findIDByExactName(tags.Tags, tagName, func(element *registry.Tag) (string, string) {
+		return element.Name, element.Digest
+	})
+	if err != nil {
+		return "", fmt.Errorf("tag %s of image %s: %w", tagName, imageName, err)
+	}
+
+	return digest, nil
+}
@@ -0,0 +1,89 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegistryImage(t *testing.T) {
+	region, namespace, name, tag, digest, err := parseRegistryImage("rg.nl-ams.scw.cloud/my-namespace/api:v1.2.0")
+	assert.NoError(t, err)
+	assert.Equal(t, scw.RegionNlAms, region)
+	assert.Equal(t, "my-namespace", namespace)
+	assert.Equal(t, "api", name)
+	assert.Equal(t, "v1.2.0", tag)
+	assert.Equal(t, "", digest)
+
+	_, _, name, tag, _, err = parseRegistryImage("rg.fr-par.scw.cloud/my-namespace/tools/worker")
+	assert.NoError(t, err)
+	assert.Equal(t, "tools/worker", name)
+	assert.Equal(t, "latest", tag)
+
+	_, _, name, tag, digest, err = parseRegistryImage("rg.fr-par.scw.cloud/my-namespace/api@sha256:0123456789abcdef")
+	assert.NoError(t, err)
+	assert.Equal(t, "api", name)
+	assert.Equal(t, "", tag)
+	assert.Equal(t, "sha256:0123456789abcdef", digest)
+
+	_, _, name, tag, digest, err = parseRegistryImage("rg.fr-par.scw.cloud/my-namespace/api:v1.2.0@sha256:0123456789abcdef")
+	assert.NoError(t, err)
+	assert.Equal(t, "api", name)
+	assert.Equal(t, "", tag)
+	assert.Equal(t, "sha256:0123456789abcdef", digest)
+
+	_, _, _, _, _, err = parseRegistryImage("rg.fr-par.scw.cloud/my-namespace/api@sha256:")
+	assert.Error(t, err)
+
+	_, _, _, _, _, err = parseRegistryImage("rg.fr-par.scw.cloud/my-namespace/api@md5:0123456789abcdef")
+	assert.Error(t, err)
+
+	_, _, _, _, _, err = parseRegistryImage("docker.io/library/nginx:latest")
+	assert.Error(t, err)
+}
+
+func TestResolveRegistryImageDigest(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		// Name filters match names like the requested one, responses include the similar names
+		switch r.URL.Path {
+		case "/registry/v1/regions/fr-par/namespaces":
+			_, _ = w.Write([]byte(`{"namespaces":[{"id":"ns-other","name":"my-namespace-2"},{"id":"ns","name":"my-namespace"}],"total_count":2}`))
+		case "/registry/v1/regions/fr-par/images":
+			assert.Equal(t, "ns", r.URL.Query().Get("namespace_id"))
+			_, _ = w.Write([]byte(`{"images":[{"id":"image-other","name":"api-v2"},{"id":"image","name":"api"}],"total_count":2}`))
+		case "/registry/v1/regions/fr-par/images/image/tags":
+			_, _ = w.Write([]byte(`{"tags":[{"id":"tag-other","name":"v1-rc","digest":"sha256:other"},{"id":"tag","name":"v1","digest":"sha256:expected"}],"total_count":2}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL))
+	require.NoError(t, err)
+	meta := &Meta{scwClient: client}
+
+	digest, err := resolveRegistryImageDigest(context.Background(), meta, "rg.fr-par.scw.cloud/my-namespace/api:v1")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:expected", digest)
+	assert.Equal(t, 3, requests)
+
+	_, err = resolveRegistryImageDigest(context.Background(), meta, "rg.fr-par.scw.cloud/my-namespace/api:v2")
+	assert.ErrorContains(t, err, `no resource found with the name "v2"`)
+
+	// A pinned digest is used as is, without listing the registry
+	requests = 0
+	digest, err = resolveRegistryImageDigest(context.Background(), meta, "rg.fr-par.scw.cloud/my-namespace/api@sha256:pinned")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:pinned", digest)
+	assert.Equal(t, 0, requests)
+}
@@ -1,7 +1,12 @@
 package scaleway
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,6 +16,9 @@ import (
 
 const (
 	defaultSecretTimeout = 5 * time.Minute
+
+	// secretVersionRevisionLatest is the revision alias targeting the most recent secret version
+	secretVersionRevisionLatest = "latest"
 )
 
 // secretAPIWithRegion returns a new Secret API and the region for a Create request
@@ -79,3 +87,85 @@ func base64Encoded(data []byte) string {
 	}
 	return base64.StdEncoding.EncodeToString(data)
 }
+
+// updateSecretProtection protects or unprotects a secret
+func updateSecretProtection(ctx context.Context, api *secret.API, region scw.Region, secretID string, protected bool) error {
+	var err error
+	if protected {
+		_, err = api.ProtectSecret(&secret.ProtectSecretRequest{
+			Region:   region,
+			SecretID: secretID,
+		}, scw.WithContext(ctx))
+	} else {
+		_, err = api.UnprotectSecret(&secret.UnprotectSecretRequest{
+			Region:   region,
+			SecretID: secretID,
+		}, scw.WithContext(ctx))
+	}
+
+	return err
+}
+
+// updateSecretVersionStatus enables or disables a secret version
+func updateSecretVersionStatus(ctx context.Context, api *secret.API, region scw.Region, secretID string, revision string, disabled bool) error {
+	var err error
+	if disabled {
+		_, err = api.DisableSecretVersion(&secret.DisableSecretVersionRequest{
+			Region:   region,
+			SecretID: secretID,
+			Revision: revision,
+		}, scw.WithContext(ctx))
+	} else {
+		_, err = api.EnableSecretVersion(&secret.EnableSecretVersionRequest{
+			Region:   region,
+			SecretID: secretID,
+			Revision: revision,
+		}, scw.WithContext(ctx))
+	}
+
+	return err
+}
+
+// validateSecretCertificatePayload checks that a certificate secret payload is a PEM bundle
+// containing exactly one private key and at least one certificate
+func validateSecretCertificatePayload(data []byte) error {
+	privateKeys := 0
+	certificates := 0
+
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		switch {
+		case block.Type == "CERTIFICATE":
+			certificates++
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			privateKeys++
+		}
+	}
+
+	if certificates == 0 {
+		return errors.New("certificate secret payload must contain at least one PEM encoded certificate")
+	}
+	if privateKeys != 1 {
+		return errors.New("certificate secret payload must contain exactly one PEM encoded private key")
+	}
+
+	return nil
+}
+
+// secretVersionCreationError returns the error to report when a secret version could not be created.
+// If the secret is a certificate, the payload is checked to give a more precise error than the API.
+func secretVersionCreationError(ctx context.Context, api *secret.API, region scw.Region, secretID string, data []byte, err error) error {
+	secretResponse, getErr := api.GetSecret(&secret.GetSecretRequest{
+		Region:   region,
+		SecretID: secretID,
+	}, scw.WithContext(ctx))
+	if getErr != nil || secretResponse.Type != secret.SecretTypeCertificate {
+		return err
+	}
+
+	payloadErr := validateSecretCertificatePayload(data)
+	if payloadErr != nil {
+		return fmt.Errorf("%w: %s", payloadErr, err)
+	}
+
+	return err
+}
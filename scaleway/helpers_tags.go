@@ -0,0 +1,183 @@
+package scaleway
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tagKey returns the key of a "key=value" tag, or the whole tag if it has no value.
+func tagKey(tag string) string {
+	key, _, _ := strings.Cut(tag, "=")
+	return key
+}
+
+// mergeDefaultTags appends default tags to tags.
+// A tag sharing its key with a default tag overrides it.
+func mergeDefaultTags(defaultTags []string, tags []string) []string {
+	if len(defaultTags) == 0 {
+		return tags
+	}
+
+	keys := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		keys[tagKey(tag)] = struct{}{}
+	}
+
+	merged := append([]string(nil), tags...)
+	for _, tag := range defaultTags {
+		if _, exist := keys[tagKey(tag)]; exist {
+			continue
+		}
+		keys[tagKey(tag)] = struct{}{}
+		merged = append(merged, tag)
+	}
+	return merged
+}
+
+// removeDefaultTags removes from tags the default tags that are not part of configuredTags.
+func removeDefaultTags(defaultTags []string, configuredTags []string, tags []string) []string {
+	if len(defaultTags) == 0 {
+		return tags
+	}
+
+	ignored := make(map[string]struct{}, len(defaultTags))
+	for _, tag := range defaultTags {
+		ignored[tag] = struct{}{}
+	}
+	for _, tag := range configuredTags {
+		delete(ignored, tag)
+	}
+
+	var filtered []string
+	for _, tag := range tags {
+		if _, isDefault := ignored[tag]; isDefault {
+			continue
+		}
+		filtered = append(filtered, tag)
+	}
+	return filtered
+}
+
+// sameTags returns true if a and b contain the same tags, in any order.
+func sameTags(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isIgnoredTag returns true if tag starts with one of the ignored prefixes.
+func isIgnoredTag(ignoredPrefixes []string, tag string) bool {
+	for _, prefix := range ignoredPrefixes {
+		if strings.HasPrefix(tag, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeIgnoredTags removes from tags the ones matching an ignored prefix.
+func removeIgnoredTags(ignoredPrefixes []string, tags []string) []string {
+	if len(ignoredPrefixes) == 0 {
+		return tags
+	}
+
+	var filtered []string
+	for _, tag := range tags {
+		if !isIgnoredTag(ignoredPrefixes, tag) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
+
+// appendIgnoredTags appends to tags the remote tags matching an ignored prefix,
+// so they are not removed when updating the resource.
+func appendIgnoredTags(ignoredPrefixes []string, tags []string, remoteTags []string) []string {
+	for _, tag := range remoteTags {
+		if isIgnoredTag(ignoredPrefixes, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// expandResourceTags returns the resource tags merged with the provider default tags.
+func expandResourceTags(d *schema.ResourceData, m interface{}) []string {
+	return mergeDefaultTags(m.(*Meta).defaultTags, expandStrings(d.Get("tags")))
+}
+
+// expandUpdatedResourceTags is the update counterpart of expandResourceTags, it defaults to an empty list.
+func expandUpdatedResourceTags(d *schema.ResourceData, m interface{}) *[]string {
+	tags := mergeDefaultTags(m.(*Meta).defaultTags, *expandUpdatedStringsPtr(d.Get("tags")))
+	return &tags
+}
+
+// expandUpdatedInstanceTags is expandUpdatedResourceTags for instance resources,
+// ignored tags found in remoteTags are kept.
+func expandUpdatedInstanceTags(d *schema.ResourceData, m interface{}, remoteTags []string) *[]string {
+	tags := appendIgnoredTags(m.(*Meta).ignoreTags, *expandUpdatedResourceTags(d, m), remoteTags)
+	return &tags
+}
+
+// hasIgnoredTags returns true if the provider is configured to ignore some tags.
+// Remote tags must then be fetched before updating instance resource tags.
+func hasIgnoredTags(m interface{}) bool {
+	return len(m.(*Meta).ignoreTags) > 0
+}
+
+// flattenResourceTags removes the provider default tags from the tags returned by the API
+// so they do not show up as a diff on the resource.
+func flattenResourceTags(d *schema.ResourceData, m interface{}, tags []string) []string {
+	return removeDefaultTags(m.(*Meta).defaultTags, expandStrings(d.Get("tags")), tags)
+}
+
+// flattenInstanceTags is flattenResourceTags for instance resources, it also removes ignored tags.
+func flattenInstanceTags(d *schema.ResourceData, m interface{}, tags []string) []string {
+	return removeIgnoredTags(m.(*Meta).ignoreTags, flattenResourceTags(d, m, tags))
+}
+
+// tagsAllSchema returns the schema of tags_all, the tags of the resource merged with the provider default tags.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed:    true,
+		Description: "The tags of the resource, including the provider default tags",
+	}
+}
+
+// customizeDiffDefaultTags plans an update of tags_all when the resource tags merged with the provider
+// default tags differ from the tags of the resource, e.g. after a change of the default_tags block.
+func customizeDiffDefaultTags(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	tags := mergeDefaultTags(m.(*Meta).defaultTags, expandStrings(diff.Get("tags")))
+	if diff.Id() != "" && sameTags(tags, expandStrings(diff.Get("tags_all"))) {
+		return nil
+	}
+
+	return diff.SetNewComputed("tags_all")
+}
+
+// flattenInstanceTagsAll returns the tags_all of instance resources, ignored tags are removed.
+func flattenInstanceTagsAll(m interface{}, tags []string) []string {
+	return removeIgnoredTags(m.(*Meta).ignoreTags, tags)
+}
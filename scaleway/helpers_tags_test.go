@@ -0,0 +1,84 @@
+package scaleway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	assert.Equal(t, []string{"foo"}, mergeDefaultTags(nil, []string{"foo"}))
+	assert.Nil(t, mergeDefaultTags(nil, nil))
+	assert.Equal(t, []string{"team=infra", "cost"}, mergeDefaultTags([]string{"team=infra", "cost"}, nil))
+	assert.Equal(t, []string{"foo", "team=web", "cost"}, mergeDefaultTags([]string{"team=infra", "cost"}, []string{"foo", "team=web"}))
+	assert.Equal(t, []string{"cost"}, mergeDefaultTags([]string{"cost"}, []string{"cost"}))
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	defaultTags := []string{"team=infra", "cost"}
+
+	assert.Equal(t, []string{"foo"}, removeDefaultTags(nil, nil, []string{"foo"}))
+	assert.Equal(t, []string{"foo"}, removeDefaultTags(defaultTags, []string{"foo"}, []string{"foo", "team=infra", "cost"}))
+	assert.Equal(t, []string{"foo", "cost"}, removeDefaultTags(defaultTags, []string{"foo", "cost"}, []string{"foo", "team=infra", "cost"}))
+	assert.Equal(t, []string{"team=web"}, removeDefaultTags(defaultTags, []string{"team=web"}, []string{"team=web", "cost"}))
+	assert.Nil(t, removeDefaultTags(defaultTags, nil, []string{"team=infra", "cost"}))
+}
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	assert.Equal(t, []string{"foo"}, removeIgnoredTags(nil, []string{"foo"}))
+	assert.Equal(t, []string{"foo"}, removeIgnoredTags([]string{"k8s.", "backup"}, []string{"foo", "k8s.io/cluster", "backup=daily"}))
+	assert.Nil(t, removeIgnoredTags([]string{"k8s."}, []string{"k8s.io/cluster"}))
+}
+
+func TestAppendIgnoredTags(t *testing.T) {
+	assert.Equal(t, []string{"foo"}, appendIgnoredTags(nil, []string{"foo"}, []string{"k8s.io/cluster"}))
+	assert.Equal(t, []string{"foo", "k8s.io/cluster"}, appendIgnoredTags([]string{"k8s."}, []string{"foo"}, []string{"bar", "k8s.io/cluster"}))
+}
+
+func TestSameTags(t *testing.T) {
+	assert.True(t, sameTags(nil, []string{}))
+	assert.True(t, sameTags([]string{"foo", "team=infra"}, []string{"team=infra", "foo"}))
+	assert.False(t, sameTags([]string{"foo"}, []string{"foo", "team=infra"}))
+	assert.False(t, sameTags([]string{"foo", "team=web"}, []string{"foo", "team=infra"}))
+}
+
+func TestCustomizeDiffDefaultTags(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "fr-par/11111111-1111-1111-1111-111111111111",
+		Attributes: map[string]string{
+			"id":         "fr-par/11111111-1111-1111-1111-111111111111",
+			"name":       "vpc",
+			"tags.#":     "1",
+			"tags.0":     "foo",
+			"tags_all.#": "2",
+			"tags_all.0": "foo",
+			"tags_all.1": "team=infra",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "vpc",
+		"tags": []interface{}{"foo"},
+	})
+
+	tests := []struct {
+		name           string
+		defaultTags    []string
+		expectedUpdate bool
+	}{
+		{name: "default tags applied", defaultTags: []string{"team=infra"}},
+		{name: "default tag changed", defaultTags: []string{"team=web"}, expectedUpdate: true},
+		{name: "default tag added", defaultTags: []string{"team=infra", "cost"}, expectedUpdate: true},
+		{name: "default tag removed", expectedUpdate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff, err := resourceScalewayVPC().Diff(context.Background(), state, config, &Meta{defaultTags: tt.defaultTags})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedUpdate, diff != nil && diff.Attributes["tags_all.#"] != nil)
+		})
+	}
+}
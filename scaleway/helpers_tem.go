@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,8 +11,11 @@ import (
 )
 
 const (
-	defaultTemDomainTimeout       = 5 * time.Minute
-	defaultTemDomainRetryInterval = 15 * time.Second
+	defaultTemDomainTimeout           = 5 * time.Minute
+	defaultTemDomainRetryInterval     = 15 * time.Second
+	defaultTemDomainValidationTimeout = 10 * time.Minute
+
+	temDomainDKIMNameTmpl = "%s._domainkey"
 )
 
 // temAPIWithRegion returns a new Tem API and the region for a Create request
@@ -53,3 +57,8 @@ func waitForTemDomain(ctx context.Context, api *tem.API, region scw.Region, id s
 
 	return domain, err
 }
+
+// temDomainDKIMName returns the name of the DKIM record, which depends on the project of the domain
+func temDomainDKIMName(projectID string) string {
+	return fmt.Sprintf(temDomainDKIMNameTmpl, projectID)
+}
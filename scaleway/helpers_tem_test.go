@@ -0,0 +1,11 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemDomainDKIMName(t *testing.T) {
+	assert.Equal(t, "11111111-1111-1111-1111-111111111111._domainkey", temDomainDKIMName("11111111-1111-1111-1111-111111111111"))
+}
@@ -223,6 +223,13 @@ func TestIs403Error(t *testing.T) {
 	assert.False(t, is403Error(&scw.ResponseError{StatusCode: http.StatusBadRequest}))
 }
 
+func TestReverseDNSEquals(t *testing.T) {
+	assert.True(t, reverseDNSEquals("www.example.com", "www.example.com"))
+	assert.True(t, reverseDNSEquals("www.example.com.", "WWW.Example.com"))
+	assert.False(t, reverseDNSEquals("www.example.com", "example.com"))
+	assert.False(t, reverseDNSEquals("", "example.com"))
+}
+
 func TestGetRandomName(t *testing.T) {
 	name := newRandomName("test")
 	assert.True(t, strings.HasPrefix(name, "tf-test-"))
@@ -234,3 +234,116 @@ func vpcPrivateNetworkUpgradeV1ZonalToRegionalID(element string) (string, error)
 
 	return fmt.Sprintf("%s/%s", fetchRegion.String(), id), nil
 }
+
+// vpcPrivateNetworkIDsUpgradeFunc returns a state upgrade function converting the zonal private network IDs
+// referenced by the given attributes to regional IDs. Attributes nested in a list use the "list.#.key" notation.
+func vpcPrivateNetworkIDsUpgradeFunc(keys ...string) schema.StateUpgradeFunc {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		for _, key := range keys {
+			listKey, nestedKey, isNested := strings.Cut(key, ".#.")
+			if !isNested {
+				if err := upgradeRawPrivateNetworkID(rawState, key); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			rawList, _ := rawState[listKey].([]interface{})
+			for _, rawElement := range rawList {
+				element, isMap := rawElement.(map[string]interface{})
+				if !isMap {
+					continue
+				}
+				if err := upgradeRawPrivateNetworkID(element, nestedKey); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+// upgradeRawPrivateNetworkID converts the zonal private network ID stored at key, IDs without locality are kept as is.
+func upgradeRawPrivateNetworkID(rawState map[string]interface{}, key string) error {
+	id, _ := rawState[key].(string)
+	if id == "" || !strings.Contains(id, "/") {
+		return nil
+	}
+
+	regionalID, err := vpcPrivateNetworkUpgradeV1ZonalToRegionalID(id)
+	if err != nil {
+		return err
+	}
+	rawState[key] = regionalID
+	return nil
+}
+
+// findVPCIDByName returns the ID of the VPC with the given "<region>/<name>", it is used to import by name.
+func findVPCIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, region, name, err := vpcAPIWithRegionAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListVPCs(&v2.ListVPCsRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Vpcs, name, func(element *v2.VPC) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newRegionalIDString(region, id), nil
+}
+
+// findVPCPrivateNetworkIDByName returns the ID of the PrivateNetwork with the given "<region>/<name>", it is used to import by name.
+func findVPCPrivateNetworkIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, region, name, err := vpcAPIWithRegionAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListPrivateNetworks(&v2.ListPrivateNetworksRequest{
+		Region: region,
+		Name:   &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.PrivateNetworks, name, func(element *v2.PrivateNetwork) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newRegionalIDString(region, id), nil
+}
+
+// findVPCPrivateNetworkIDBySubnetID returns the ID of the private network owning the given subnet, or nil if none does.
+func findVPCPrivateNetworkIDBySubnetID(ctx context.Context, m interface{}, region scw.Region, projectID string, subnetID string) (*string, error) {
+	res, err := v2.NewAPI(m.(*Meta).scwClient).ListPrivateNetworks(&v2.ListPrivateNetworksRequest{
+		Region:    region,
+		ProjectID: expandStringPtr(projectID),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, privateNetwork := range res.PrivateNetworks {
+		for _, subnet := range privateNetwork.Subnets {
+			if subnet.ID == subnetID {
+				return scw.StringPtr(privateNetwork.ID), nil
+			}
+		}
+	}
+
+	return nil, nil
+}
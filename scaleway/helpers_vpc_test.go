@@ -0,0 +1,37 @@
+package scaleway
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestVPCPrivateNetworkIDsUpgradeFunc(t *testing.T) {
+	v0Schema := map[string]interface{}{
+		"id":                 "fr-par-1/11111111-1111-1111-1111-111111111111",
+		"private_network_id": "fr-par-1/22222222-2222-2222-2222-222222222222",
+		"private_network": []interface{}{
+			map[string]interface{}{"pn_id": "nl-ams-1/33333333-3333-3333-3333-333333333333"},
+			map[string]interface{}{"pn_id": "nl-ams/44444444-4444-4444-4444-444444444444"},
+			map[string]interface{}{"pn_id": ""},
+		},
+	}
+	v1Schema := map[string]interface{}{
+		"id":                 "fr-par-1/11111111-1111-1111-1111-111111111111",
+		"private_network_id": "fr-par/22222222-2222-2222-2222-222222222222",
+		"private_network": []interface{}{
+			map[string]interface{}{"pn_id": "nl-ams/33333333-3333-3333-3333-333333333333"},
+			map[string]interface{}{"pn_id": "nl-ams/44444444-4444-4444-4444-444444444444"},
+			map[string]interface{}{"pn_id": ""},
+		},
+	}
+
+	actual, err := vpcPrivateNetworkIDsUpgradeFunc("private_network_id", "private_network.#.pn_id")(context.Background(), v0Schema, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(v1Schema, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", v1Schema, actual)
+	}
+}
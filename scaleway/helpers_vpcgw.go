@@ -3,6 +3,9 @@ package scaleway
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -126,3 +129,69 @@ func retryUpdateGatewayReverseDNS(ctx context.Context, api *vpcgw.API, req *vpcg
 		}
 	}
 }
+
+// findVPCPublicGatewayIDByName returns the ID of the Gateway with the given "<zone>/<name>", it is used to import by name.
+func findVPCPublicGatewayIDByName(ctx context.Context, m interface{}, localizedName string) (string, error) {
+	api, zone, name, err := vpcgwAPIWithZoneAndID(m, localizedName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.ListGateways(&vpcgw.ListGatewaysRequest{
+		Zone: zone,
+		Name: &name,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	id, err := findIDByExactName(res.Gateways, name, func(element *vpcgw.Gateway) (string, string) {
+		return element.Name, element.ID
+	})
+	if err != nil {
+		return "", err
+	}
+	return newZonedIDString(zone, id), nil
+}
+
+// importVPCPublicGatewayDHCPReservation imports a DHCP reservation either by "<zone>/<id>" or by "<zone>/<gateway-network-id>/<mac-address>".
+// Dynamic leases are not reservations and cannot be imported.
+func importVPCPublicGatewayDHCPReservation(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if strings.Count(d.Id(), "/") != 2 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	separator := strings.LastIndex(d.Id(), "/")
+	zone, gatewayNetworkID, err := parseZonedID(d.Id()[:separator])
+	if err != nil {
+		return nil, fmt.Errorf("cannot import %q, expected <zone>/<id> or <zone>/<gateway-network-id>/<mac-address>: %w", d.Id(), err)
+	}
+	macAddress, err := net.ParseMAC(d.Id()[separator+1:])
+	if err != nil {
+		return nil, fmt.Errorf("cannot import %q, expected <zone>/<id> or <zone>/<gateway-network-id>/<mac-address>: %w", d.Id(), err)
+	}
+
+	api := vpcgw.NewAPI(m.(*Meta).scwClient)
+	res, err := api.ListDHCPEntries(&vpcgw.ListDHCPEntriesRequest{
+		Zone:             zone,
+		GatewayNetworkID: scw.StringPtr(gatewayNetworkID),
+		MacAddress:       scw.StringPtr(macAddress.String()),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	hasLease := false
+	for _, entry := range res.DHCPEntries {
+		if entry.Type == vpcgw.DHCPEntryTypeReservation {
+			d.SetId(newZonedIDString(zone, entry.ID))
+			return []*schema.ResourceData{d}, nil
+		}
+		hasLease = hasLease || entry.Type == vpcgw.DHCPEntryTypeLease
+	}
+	if hasLease {
+		return nil, fmt.Errorf("cannot import %q: the MAC address %s only has a dynamic lease, create the reservation instead", d.Id(), macAddress)
+	}
+
+	return nil, fmt.Errorf("cannot import %q: no DHCP reservation found for the MAC address %s", d.Id(), macAddress)
+}
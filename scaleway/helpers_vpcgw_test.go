@@ -0,0 +1,62 @@
+package scaleway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportVPCPublicGatewayDHCPReservation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/vpc-gw/v1/zones/fr-par-1/dhcp-entries", r.URL.Path)
+		assert.Equal(t, "11111111-1111-1111-1111-111111111111", r.URL.Query().Get("gateway_network_id"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("mac_address") {
+		case "00:11:22:33:44:55":
+			_, _ = w.Write([]byte(`{"dhcp_entries":[{"id":"lease","type":"lease"},{"id":"reservation","type":"reservation"}],"total_count":2}`))
+		case "00:11:22:33:44:66":
+			_, _ = w.Write([]byte(`{"dhcp_entries":[{"id":"lease","type":"lease"}],"total_count":1}`))
+		default:
+			_, _ = w.Write([]byte(`{"dhcp_entries":[],"total_count":0}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL))
+	require.NoError(t, err)
+	meta := &Meta{scwClient: client}
+
+	tests := []struct {
+		name       string
+		id         string
+		expectedID string
+		err        string
+	}{
+		{name: "zoned ID", id: "fr-par-1/22222222-2222-2222-2222-222222222222", expectedID: "fr-par-1/22222222-2222-2222-2222-222222222222"},
+		{name: "reservation", id: "fr-par-1/11111111-1111-1111-1111-111111111111/00:11:22:33:44:55", expectedID: "fr-par-1/reservation"},
+		{name: "lease only", id: "fr-par-1/11111111-1111-1111-1111-111111111111/00:11:22:33:44:66", err: "only has a dynamic lease"},
+		{name: "no entry", id: "fr-par-1/11111111-1111-1111-1111-111111111111/00:11:22:33:44:77", err: "no DHCP reservation found"},
+		{name: "invalid zone", id: "fr-par/11111111-1111-1111-1111-111111111111/00:11:22:33:44:55", err: "expected <zone>/<id> or <zone>/<gateway-network-id>/<mac-address>"},
+		{name: "invalid MAC address", id: "fr-par-1/11111111-1111-1111-1111-111111111111/invalid", err: "expected <zone>/<id> or <zone>/<gateway-network-id>/<mac-address>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceScalewayVPCPublicGatewayDHCPReservation().Data(nil)
+			d.SetId(tt.id)
+
+			res, err := importVPCPublicGatewayDHCPReservation(context.Background(), d, meta)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedID, res[0].Id())
+		})
+	}
+}
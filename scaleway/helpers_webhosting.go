@@ -81,6 +81,39 @@ func flattenHostingOptions(options []*webhosting.HostingOption) []map[string]int
 	return flattenedOptions
 }
 
+func flattenHostingNameServers(nameServers []*webhosting.Nameserver) []map[string]interface{} {
+	if nameServers == nil {
+		return nil
+	}
+	flattenedNameServers := []map[string]interface{}(nil)
+	for _, nameServer := range nameServers {
+		flattenedNameServers = append(flattenedNameServers, map[string]interface{}{
+			"hostname":   nameServer.Hostname,
+			"status":     nameServer.Status.String(),
+			"is_default": nameServer.IsDefault,
+		})
+	}
+	return flattenedNameServers
+}
+
+func flattenHostingDNSRecords(records []*webhosting.DNSRecord) []map[string]interface{} {
+	if records == nil {
+		return nil
+	}
+	flattenedRecords := []map[string]interface{}(nil)
+	for _, record := range records {
+		flattenedRecords = append(flattenedRecords, map[string]interface{}{
+			"name":     record.Name,
+			"type":     record.Type.String(),
+			"ttl":      int(record.TTL),
+			"value":    record.Value,
+			"priority": flattenUint32Ptr(record.Priority),
+			"status":   record.Status.String(),
+		})
+	}
+	return flattenedRecords
+}
+
 func waitForHosting(ctx context.Context, api *webhosting.API, region scw.Region, hostingID string, timeout time.Duration) (*webhosting.Hosting, error) {
 	retryInterval := hostingRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -9,12 +9,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 var terraformBetaEnabled = os.Getenv(scw.ScwEnableBeta) != ""
 
+const scwUserAgentSuffixEnv = "SCW_USER_AGENT_SUFFIX"
+
 // ProviderConfig config can be used to provide additional config when creating provider.
 type ProviderConfig struct {
 	// Meta can be used to override Meta that will be used by the provider.
@@ -62,6 +65,12 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 					Optional:    true, // To allow user to use `access_key`, `secret_key`, `project_id`...
 					Description: "The Scaleway profile to use.",
 				},
+				"env_override": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether SCW_* environment variables take precedence over the provider configuration and the selected profile.",
+				},
 				"project_id": {
 					Type:         schema.TypeString,
 					Optional:     true, // To allow user to use organization instead of project
@@ -81,6 +90,80 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 					Optional:    true,
 					Description: "The Scaleway API URL to use.",
 				},
+				"s3_endpoint": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The S3-compatible object storage endpoint to use. Can contain a {region} placeholder.",
+				},
+				"object_storage": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Credentials used for object storage instead of the provider keys.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"access_key": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The access key used to sign object storage requests.",
+							},
+							"secret_key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								Description:  "The secret key used to sign object storage requests.",
+								ValidateFunc: validationUUID(),
+							},
+						},
+					},
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaultMaxRetries,
+					Description:  "The maximum number of times an API request is retried on rate limiting or transient server errors.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc(scwMaxConcurrentRequestsEnv, 0),
+					Description:  "The maximum number of API requests running at the same time, 0 means unlimited.",
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"user_agent_suffix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc(scwUserAgentSuffixEnv, nil),
+					Description: "A suffix appended to the User-Agent of API requests, useful to identify the origin of API calls.",
+				},
+				"ignore_tags": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Tag prefixes ignored on instance servers, volumes and IPs, tags managed outside of terraform don't show up as a diff",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"default_tags": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Tags applied to all taggable resources managed by the provider.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tags": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The tags to apply, a resource tag with the same key overrides a default tag",
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
@@ -88,6 +171,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_account_ssh_key":                     resourceScalewayAccountSSKKey(),
 				"scaleway_apple_silicon_server":                resourceScalewayAppleSiliconServer(),
 				"scaleway_baremetal_server":                    resourceScalewayBaremetalServer(),
+				"scaleway_baremetal_bmc_access":                resourceScalewayBaremetalBMCAccess(),
 				"scaleway_cockpit":                             resourceScalewayCockpit(),
 				"scaleway_cockpit_token":                       resourceScalewayCockpitToken(),
 				"scaleway_cockpit_grafana_user":                resourceScalewayCockpitGrafanaUser(),
@@ -110,6 +194,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_iam_group":                           resourceScalewayIamGroup(),
 				"scaleway_iam_group_membership":                resourceScalewayIamGroupMembership(),
 				"scaleway_iam_policy":                          resourceScalewayIamPolicy(),
+				"scaleway_iam_user":                            resourceScalewayIamUser(),
 				"scaleway_instance_user_data":                  resourceScalewayInstanceUserData(),
 				"scaleway_instance_image":                      resourceScalewayInstanceImage(),
 				"scaleway_instance_ip":                         resourceScalewayInstanceIP(),
@@ -118,6 +203,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_instance_security_group":             resourceScalewayInstanceSecurityGroup(),
 				"scaleway_instance_security_group_rules":       resourceScalewayInstanceSecurityGroupRules(),
 				"scaleway_instance_server":                     resourceScalewayInstanceServer(),
+				"scaleway_instance_server_backup":              resourceScalewayInstanceServerBackup(),
 				"scaleway_instance_snapshot":                   resourceScalewayInstanceSnapshot(),
 				"scaleway_iam_ssh_key":                         resourceScalewayIamSSKKey(),
 				"scaleway_instance_placement_group":            resourceScalewayInstancePlacementGroup(),
@@ -137,6 +223,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_lb_route":                            resourceScalewayLbRoute(),
 				"scaleway_registry_namespace":                  resourceScalewayRegistryNamespace(),
 				"scaleway_tem_domain":                          resourceScalewayTemDomain(),
+				"scaleway_tem_domain_validation":               resourceScalewayTemDomainValidation(),
 				"scaleway_container":                           resourceScalewayContainer(),
 				"scaleway_container_token":                     resourceScalewayContainerToken(),
 				"scaleway_rdb_acl":                             resourceScalewayRdbACL(),
@@ -153,6 +240,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_object_bucket_lock_configuration":    resourceObjectLockConfiguration(),
 				"scaleway_object_bucket_policy":                resourceScalewayObjectBucketPolicy(),
 				"scaleway_object_bucket_website_configuration": ResourceBucketWebsiteConfiguration(),
+				"scaleway_object_bucket_notification":          resourceScalewayObjectBucketNotification(),
 				"scaleway_mnq_namespace":                       resourceScalewayMNQNamespace(),
 				"scaleway_mnq_credential":                      resourceScalewayMNQCredential(),
 				"scaleway_mnq_queue":                           resourceScalewayMNQQueue(),
@@ -172,38 +260,52 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 
 			DataSourcesMap: map[string]*schema.Resource{
 				"scaleway_account_project":                     dataSourceScalewayAccountProject(),
+				"scaleway_account_projects":                    dataSourceScalewayAccountProjects(),
 				"scaleway_account_ssh_key":                     dataSourceScalewayAccountSSHKey(),
 				"scaleway_availability_zones":                  DataSourceAvailabilityZones(),
 				"scaleway_baremetal_offer":                     dataSourceScalewayBaremetalOffer(),
 				"scaleway_baremetal_option":                    dataSourceScalewayBaremetalOption(),
 				"scaleway_baremetal_os":                        dataSourceScalewayBaremetalOs(),
 				"scaleway_baremetal_server":                    dataSourceScalewayBaremetalServer(),
+				"scaleway_billing_consumptions":                dataSourceScalewayBillingConsumptions(),
+				"scaleway_billing_invoices":                    dataSourceScalewayBillingInvoices(),
 				"scaleway_cockpit":                             dataSourceScalewayCockpit(),
 				"scaleway_cockpit_plan":                        dataSourceScalewayCockpitPlan(),
 				"scaleway_domain_record":                       dataSourceScalewayDomainRecord(),
+				"scaleway_domain_records":                      dataSourceScalewayDomainRecords(),
 				"scaleway_domain_zone":                         dataSourceScalewayDomainZone(),
 				"scaleway_container_namespace":                 dataSourceScalewayContainerNamespace(),
 				"scaleway_container":                           dataSourceScalewayContainer(),
 				"scaleway_function":                            dataSourceScalewayFunction(),
 				"scaleway_function_namespace":                  dataSourceScalewayFunctionNamespace(),
+				"scaleway_iam_api_key":                         dataSourceScalewayIamAPIKey(),
 				"scaleway_iam_application":                     dataSourceScalewayIamApplication(),
 				"scaleway_flexible_ip":                         dataSourceScalewayFlexibleIP(),
 				"scaleway_flexible_ips":                        dataSourceScalewayFlexibleIPs(),
 				"scaleway_iam_group":                           dataSourceScalewayIamGroup(),
+				"scaleway_iam_quotas":                          dataSourceScalewayIamQuotas(),
 				"scaleway_iam_ssh_key":                         dataSourceScalewayIamSSHKey(),
 				"scaleway_iam_user":                            dataSourceScalewayIamUser(),
+				"scaleway_instance_bootscripts":                dataSourceScalewayInstanceBootscripts(),
 				"scaleway_instance_ip":                         dataSourceScalewayInstanceIP(),
 				"scaleway_instance_private_nic":                dataSourceScalewayInstancePrivateNIC(),
 				"scaleway_instance_security_group":             dataSourceScalewayInstanceSecurityGroup(),
+				"scaleway_instance_security_group_check":       dataSourceScalewayInstanceSecurityGroupCheck(),
+				"scaleway_instance_security_groups":            dataSourceScalewayInstanceSecurityGroups(),
 				"scaleway_instance_server":                     dataSourceScalewayInstanceServer(),
 				"scaleway_instance_servers":                    dataSourceScalewayInstanceServers(),
 				"scaleway_instance_image":                      dataSourceScalewayInstanceImage(),
 				"scaleway_instance_volume":                     dataSourceScalewayInstanceVolume(),
+				"scaleway_instance_volumes":                    dataSourceScalewayInstanceVolumes(),
 				"scaleway_instance_snapshot":                   dataSourceScalewayInstanceSnapshot(),
+				"scaleway_instance_snapshots":                  dataSourceScalewayInstanceSnapshots(),
 				"scaleway_iot_hub":                             dataSourceScalewayIotHub(),
 				"scaleway_iot_device":                          dataSourceScalewayIotDevice(),
+				"scaleway_iot_devices":                         dataSourceScalewayIotDevices(),
 				"scaleway_ipam_ip":                             dataSourceScalewayIPAMIP(),
+				"scaleway_ipam_ip_owner":                       dataSourceScalewayIPAMIPOwner(),
 				"scaleway_k8s_cluster":                         dataSourceScalewayK8SCluster(),
+				"scaleway_k8s_clusters":                        dataSourceScalewayK8SClusters(),
 				"scaleway_k8s_pool":                            dataSourceScalewayK8SPool(),
 				"scaleway_k8s_version":                         dataSourceScalewayK8SVersion(),
 				"scaleway_lb":                                  dataSourceScalewayLb(),
@@ -223,11 +325,15 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_object_bucket_policy":                dataSourceScalewayObjectBucketPolicy(),
 				"scaleway_rdb_acl":                             dataSourceScalewayRDBACL(),
 				"scaleway_rdb_instance":                        dataSourceScalewayRDBInstance(),
+				"scaleway_rdb_instance_logs":                   dataSourceScalewayRDBInstanceLogs(),
+				"scaleway_rdb_instances":                       dataSourceScalewayRDBInstances(),
 				"scaleway_rdb_database":                        dataSourceScalewayRDBDatabase(),
 				"scaleway_rdb_database_backup":                 dataSourceScalewayRDBDatabaseBackup(),
 				"scaleway_rdb_privilege":                       dataSourceScalewayRDBPrivilege(),
 				"scaleway_redis_cluster":                       dataSourceScalewayRedisCluster(),
+				"scaleway_regions":                             dataSourceScalewayRegions(),
 				"scaleway_registry_namespace":                  dataSourceScalewayRegistryNamespace(),
+				"scaleway_registry_namespaces":                 dataSourceScalewayRegistryNamespaces(),
 				"scaleway_tem_domain":                          dataSourceScalewayTemDomain(),
 				"scaleway_secret":                              dataSourceScalewaySecret(),
 				"scaleway_secret_version":                      dataSourceScalewaySecretVersion(),
@@ -235,6 +341,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_vpc":                                 dataSourceScalewayVPC(),
 				"scaleway_vpcs":                                dataSourceScalewayVPCs(),
 				"scaleway_vpc_public_gateway":                  dataSourceScalewayVPCPublicGateway(),
+				"scaleway_vpc_public_gateways":                 dataSourceScalewayVPCPublicGateways(),
 				"scaleway_vpc_gateway_network":                 dataSourceScalewayVPCGatewayNetwork(),
 				"scaleway_vpc_public_gateway_dhcp":             dataSourceScalewayVPCPublicGatewayDHCP(),
 				"scaleway_vpc_public_gateway_dhcp_reservation": dataSourceScalewayVPCPublicGatewayDHCPReservation(),
@@ -261,7 +368,7 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				terraformVersion: terraformVersion,
 			})
 			if err != nil {
-				return nil, diag.FromErr(err)
+				return nil, diagFromErr(err)
 			}
 			return meta, nil
 		}
@@ -280,6 +387,17 @@ type Meta struct {
 	// or it can be a http.Client used to record and replay cassettes which is useful
 	// to replay recorded interactions with APIs locally
 	httpClient *http.Client
+	// s3Endpoint is the object storage endpoint, it can contain a {region} placeholder.
+	s3Endpoint string
+	// s3AccessKey and s3SecretKey override the provider keys for object storage when set.
+	s3AccessKey string
+	s3SecretKey string
+	// defaultTags are merged into the tags of every taggable resource.
+	defaultTags []string
+	// ignoreTags are tag prefixes ignored when reading resource tags.
+	ignoreTags []string
+	// lookupCache caches server types and marketplace images lookups.
+	lookupCache *lookupCache
 }
 
 type metaConfig struct {
@@ -328,12 +446,29 @@ func buildMeta(ctx context.Context, config *metaConfig) (*Meta, error) {
 	////
 	// Create scaleway SDK client
 	////
+	userAgent := fmt.Sprintf("terraform-provider/%s terraform/%s", version, config.terraformVersion)
+	if config.providerSchema != nil {
+		if suffix, exist := config.providerSchema.GetOk("user_agent_suffix"); exist {
+			userAgent += " " + suffix.(string)
+		}
+	}
+
 	opts := []scw.ClientOption{
-		scw.WithUserAgent(fmt.Sprintf("terraform-provider/%s terraform/%s", version, config.terraformVersion)),
+		scw.WithUserAgent(userAgent),
 		scw.WithProfile(profile),
 	}
 
-	httpClient := &http.Client{Transport: newRetryableTransport(http.DefaultTransport)}
+	retryOptions := retryableTransportOptions{}
+	maxConcurrentRequests := 0
+	if config.providerSchema != nil {
+		maxRetries := config.providerSchema.Get("max_retries").(int)
+		retryOptions.RetryMax = &maxRetries
+		maxConcurrentRequests = config.providerSchema.Get("max_concurrent_requests").(int)
+	}
+
+	// Requests only hold a slot while they are sent, not while waiting for a retry.
+	transport := newConcurrencyLimitedTransport(http.DefaultTransport, maxConcurrentRequests)
+	httpClient := &http.Client{Transport: newRetryableTransportWithOptions(transport, retryOptions)}
 	if config.httpClient != nil {
 		httpClient = config.httpClient
 	}
@@ -344,9 +479,34 @@ func buildMeta(ctx context.Context, config *metaConfig) (*Meta, error) {
 		return nil, err
 	}
 
+	s3Endpoint := os.Getenv(scwS3EndpointEnv)
+	var s3AccessKey, s3SecretKey string
+	var defaultTags, ignoreTags []string
+	if config.providerSchema != nil {
+		if endpoint, exist := config.providerSchema.GetOk("s3_endpoint"); exist {
+			s3Endpoint = endpoint.(string)
+		}
+		if _, exist := config.providerSchema.GetOk("object_storage"); exist {
+			s3AccessKey = config.providerSchema.Get("object_storage.0.access_key").(string)
+			s3SecretKey = config.providerSchema.Get("object_storage.0.secret_key").(string)
+		}
+		if tags, exist := config.providerSchema.GetOk("default_tags.0.tags"); exist {
+			defaultTags = expandStrings(tags)
+		}
+		if tags, exist := config.providerSchema.GetOk("ignore_tags"); exist {
+			ignoreTags = expandStrings(tags)
+		}
+	}
+
 	return &Meta{
-		scwClient:  scwClient,
-		httpClient: httpClient,
+		scwClient:   scwClient,
+		httpClient:  httpClient,
+		s3Endpoint:  s3Endpoint,
+		s3AccessKey: s3AccessKey,
+		s3SecretKey: s3SecretKey,
+		defaultTags: defaultTags,
+		ignoreTags:  ignoreTags,
+		lookupCache: newLookupCache(),
 	}, nil
 }
 
@@ -373,13 +533,18 @@ func loadProfile(ctx context.Context, d *schema.ResourceData) (*scw.Profile, err
 	envProfile := scw.LoadEnvProfile()
 
 	providerProfile := &scw.Profile{}
+	envOverride := true
 	if d != nil {
 		if profileName, exist := d.GetOk("profile"); exist {
 			profileFromConfig, err := config.GetProfile(profileName.(string))
-			if err == nil {
-				providerProfile = profileFromConfig
+			if err != nil {
+				return nil, fmt.Errorf("cannot load profile %q: %w", profileName, err)
 			}
+			// The selected profile replaces the active one so credentials of both profiles are never mixed.
+			activeProfile = &scw.Profile{}
+			providerProfile = profileFromConfig
 		}
+		envOverride = d.Get("env_override").(bool)
 		if accessKey, exist := d.GetOk("access_key"); exist {
 			providerProfile.AccessKey = scw.StringPtr(accessKey.(string))
 		}
@@ -403,7 +568,11 @@ func loadProfile(ctx context.Context, d *schema.ResourceData) (*scw.Profile, err
 		}
 	}
 
-	profile := scw.MergeProfiles(defaultZoneProfile, activeProfile, providerProfile, envProfile)
+	profiles := []*scw.Profile{activeProfile, providerProfile, envProfile}
+	if !envOverride {
+		profiles = []*scw.Profile{activeProfile, envProfile, providerProfile}
+	}
+	profile := scw.MergeProfiles(defaultZoneProfile, profiles...)
 
 	// If profile have a defaultZone but no defaultRegion we set the defaultRegion
 	// to the one of the defaultZone
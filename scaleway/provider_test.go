@@ -225,6 +225,12 @@ func cassetteMatcher(actual *http.Request, expected cassette.Request) bool {
 		actualURLValues.Del(query)
 		expectedURLValues.Del(query)
 	}
+	// The first page is the default one, lists recorded without scw.WithAllPages did not send it
+	for _, values := range []url.Values{actualURLValues, expectedURLValues} {
+		if values.Get("page") == "1" {
+			values.Del("page")
+		}
+	}
 	actualURL.RawQuery = actualURLValues.Encode()
 	expectedURL.RawQuery = expectedURLValues.Encode()
 
@@ -259,6 +265,28 @@ func cassetteMatcher(actual *http.Request, expected cassette.Request) bool {
 		cassetteBodyMatcher(actual, expected)
 }
 
+func TestCassetteMatcherPage(t *testing.T) {
+	tests := []struct {
+		name        string
+		actualURL   string
+		expectedURL string
+		match       bool
+	}{
+		{name: "first page against a single page list", actualURL: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?name=foo&page=1", expectedURL: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?name=foo", match: true},
+		{name: "single page list against a first page", actualURL: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?name=foo", expectedURL: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?name=foo&page=1", match: true},
+		{name: "second page against a single page list", actualURL: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?name=foo&page=2", expectedURL: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?name=foo", match: false},
+		{name: "other filter", actualURL: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?name=bar&page=1", expectedURL: "https://api.scaleway.com/instance/v1/zones/fr-par-1/servers?name=foo", match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := http.NewRequest(http.MethodGet, tt.actualURL, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.match, cassetteMatcher(actual, cassette.Request{Method: http.MethodGet, URL: tt.expectedURL}))
+		})
+	}
+}
+
 func cassetteSensitiveFieldsAnonymizer(i *cassette.Interaction) error {
 	var jsonBody map[string]interface{}
 	err := json.Unmarshal([]byte(i.Response.Body), &jsonBody)
@@ -292,8 +320,16 @@ func getHTTPRecoder(t *testing.T, update bool) (client *http.Client, cleanup fun
 		recorderMode = recorder.ModeRecording
 	}
 
+	// The recorder silently records a missing cassette, acceptance tests replaying it would call the real API
+	cassettePath := getTestFilePath(t, ".cassette")
+	if !update && os.Getenv(resource.EnvTfAcc) != "" {
+		if _, err := os.Stat(cassettePath + ".yaml"); os.IsNotExist(err) {
+			return nil, nil, fmt.Errorf("cassette %s.yaml not found, record it with TF_UPDATE_CASSETTES=true", cassettePath)
+		}
+	}
+
 	// Setup recorder and scw client
-	r, err := recorder.NewAsMode(getTestFilePath(t, ".cassette"), recorderMode, nil)
+	r, err := recorder.NewAsMode(cassettePath, recorderMode, nil)
 	if err != nil {
 		return nil, nil, err
 	}
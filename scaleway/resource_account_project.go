@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	accountV3 "github.com/scaleway/scaleway-sdk-go/api/account/v3"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -18,6 +19,10 @@ func resourceScalewayAccountProject() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete:  schema.DefaultTimeout(defaultAccountProjectTimeout),
+			Default: schema.DefaultTimeout(defaultAccountProjectTimeout),
+		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -67,7 +72,7 @@ func resourceScalewayAccountProjectCreate(ctx context.Context, d *schema.Resourc
 
 	res, err := accountAPI.CreateProject(request, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(res.ID)
@@ -85,7 +90,7 @@ func resourceScalewayAccountProjectRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -118,7 +123,7 @@ func resourceScalewayAccountProjectUpdate(ctx context.Context, d *schema.Resourc
 	if hasChanged {
 		_, err := accountAPI.UpdateProject(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -128,11 +133,24 @@ func resourceScalewayAccountProjectUpdate(ctx context.Context, d *schema.Resourc
 func resourceScalewayAccountProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountAPI := accountV3ProjectAPI(meta)
 
-	err := accountAPI.DeleteProject(&accountV3.ProjectAPIDeleteProjectRequest{
-		ProjectID: d.Id(),
-	}, scw.WithContext(ctx))
-	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+	// Resources of the project may still be being deleted, retry until the project is empty
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		errDelete := accountAPI.DeleteProject(&accountV3.ProjectAPIDeleteProjectRequest{
+			ProjectID: d.Id(),
+		}, scw.WithContext(ctx))
+		if errDelete != nil {
+			if is404Error(errDelete) {
+				return nil
+			}
+			if is409Error(errDelete) || is412Error(errDelete) {
+				return resource.RetryableError(errDelete)
+			}
+			return resource.NonRetryableError(errDelete)
+		}
+		return nil
+	})
+	if err != nil {
+		return diagFromErr(err)
 	}
 
 	return nil
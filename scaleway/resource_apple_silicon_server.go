@@ -84,7 +84,7 @@ func resourceScalewayAppleSiliconServer() *schema.Resource {
 func resourceScalewayAppleSiliconServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	asAPI, zone, err := asAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	createReq := &applesilicon.CreateServerRequest{
@@ -95,14 +95,14 @@ func resourceScalewayAppleSiliconServerCreate(ctx context.Context, d *schema.Res
 
 	res, err := asAPI.CreateServer(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, res.ID))
 
 	_, err = waitForAppleSiliconServer(ctx, asAPI, zone, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayAppleSiliconServerRead(ctx, d, meta)
@@ -111,7 +111,7 @@ func resourceScalewayAppleSiliconServerCreate(ctx context.Context, d *schema.Res
 func resourceScalewayAppleSiliconServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	asAPI, zone, ID, err := asAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := asAPI.GetServer(&applesilicon.GetServerRequest{
@@ -123,7 +123,7 @@ func resourceScalewayAppleSiliconServerRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -145,7 +145,7 @@ func resourceScalewayAppleSiliconServerRead(ctx context.Context, d *schema.Resou
 func resourceScalewayAppleSiliconServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	asAPI, zone, ID, err := asAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &applesilicon.UpdateServerRequest{
@@ -159,7 +159,7 @@ func resourceScalewayAppleSiliconServerUpdate(ctx context.Context, d *schema.Res
 
 	_, err = asAPI.UpdateServer(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayAppleSiliconServerRead(ctx, d, meta)
@@ -168,7 +168,7 @@ func resourceScalewayAppleSiliconServerUpdate(ctx context.Context, d *schema.Res
 func resourceScalewayAppleSiliconServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	asAPI, zone, ID, err := asAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = asAPI.DeleteServer(&applesilicon.DeleteServerRequest{
@@ -177,7 +177,7 @@ func resourceScalewayAppleSiliconServerDelete(ctx context.Context, d *schema.Res
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
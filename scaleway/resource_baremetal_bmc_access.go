@@ -0,0 +1,145 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayBaremetalBMCAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayBaremetalBMCAccessCreate,
+		ReadContext:   resourceScalewayBaremetalBMCAccessRead,
+		DeleteContext: resourceScalewayBaremetalBMCAccessDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultBaremetalServerTimeout),
+			Default: schema.DefaultTimeout(defaultBaremetalServerTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the server to access",
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "The IP authorized to connect to the BMC",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the server console",
+			},
+			"login": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The login of the BMC access",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of the BMC access",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date after which the BMC access is closed",
+			},
+			"zone": zoneSchema(),
+		},
+		CustomizeDiff: customizeDiffLocalityCheck("server_id"),
+	}
+}
+
+func resourceScalewayBaremetalBMCAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	baremetalAPI, zone, err := baremetalAPIWithZone(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+	zonedID := newZonedID(zone, expandID(d.Get("server_id")))
+
+	_, err = baremetalAPI.StartBMCAccess(&baremetal.StartBMCAccessRequest{
+		Zone:     zonedID.Zone,
+		ServerID: zonedID.ID,
+		IP:       net.ParseIP(d.Get("ip").(string)),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diagFromErr(fmt.Errorf("failed to start BMC access, the remote access option must be enabled on the server: %w", err))
+	}
+
+	d.SetId(zonedID.String())
+
+	_, err = waitForBaremetalBMCAccess(ctx, baremetalAPI, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	return resourceScalewayBaremetalBMCAccessRead(ctx, d, meta)
+}
+
+func resourceScalewayBaremetalBMCAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	bmcAccess, err := baremetalAPI.GetBMCAccess(&baremetal.GetBMCAccessRequest{
+		Zone:     zonedID.Zone,
+		ServerID: zonedID.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	// An expired access is closed, removing it from the state opens a new one on next apply
+	if bmcAccess.ExpiresAt != nil && bmcAccess.ExpiresAt.Before(time.Now()) {
+		tflog.Warn(ctx, fmt.Sprintf("BMC access of server %s expired at %s, removing from state", zonedID.ID, bmcAccess.ExpiresAt.Format(time.RFC3339)))
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("url", bmcAccess.URL)
+	_ = d.Set("login", bmcAccess.Login)
+	_ = d.Set("password", bmcAccess.Password)
+	_ = d.Set("expires_at", flattenTime(bmcAccess.ExpiresAt))
+	_ = d.Set("zone", zonedID.Zone.String())
+
+	return nil
+}
+
+func resourceScalewayBaremetalBMCAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	err = baremetalAPI.StopBMCAccess(&baremetal.StopBMCAccessRequest{
+		Zone:     zonedID.Zone,
+		ServerID: zonedID.ID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diagFromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/api/baremetal/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccScalewayBaremetalBMCAccess_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	SSHKeyName := "TestAccScalewayBaremetalBMCAccess_Basic"
+	name := "TestAccScalewayBaremetalBMCAccess_Basic"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayBaremetalBMCAccessDestroy(tt),
+			testAccCheckScalewayBaremetalServerDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "scaleway_baremetal_os" "my_os" {
+					  zone    = "fr-par-2"
+					  name    = "Ubuntu"
+					  version = "22.04 LTS (Jammy Jellyfish)"
+					}
+
+					data "scaleway_baremetal_offer" "my_offer" {
+					  zone = "fr-par-2"
+					  name = "EM-B112X-SSD"
+					}
+
+					data "scaleway_baremetal_option" "remote_access" {
+					  zone = "fr-par-2"
+					  name = "Remote Access"
+					}
+
+					resource "scaleway_iam_ssh_key" "base" {
+					  name       = "%s"
+					  public_key = "%s"
+					}
+
+					resource "scaleway_baremetal_server" "base" {
+					  name        = "%s"
+					  zone        = "fr-par-2"
+					  offer       = data.scaleway_baremetal_offer.my_offer.offer_id
+					  os          = data.scaleway_baremetal_os.my_os.os_id
+					  ssh_key_ids = [scaleway_iam_ssh_key.base.id]
+
+					  options {
+						id = data.scaleway_baremetal_option.remote_access.option_id
+					  }
+					}
+
+					resource "scaleway_baremetal_bmc_access" "main" {
+					  server_id = scaleway_baremetal_server.base.id
+					  zone      = "fr-par-2"
+					  ip        = "51.15.1.1"
+					}
+				`, SSHKeyName, SSHKeyBaremetal, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayBaremetalBMCAccessExists(tt, "scaleway_baremetal_bmc_access.main"),
+					resource.TestCheckResourceAttrPair("scaleway_baremetal_bmc_access.main", "id", "scaleway_baremetal_server.base", "id"),
+					resource.TestCheckResourceAttr("scaleway_baremetal_bmc_access.main", "ip", "51.15.1.1"),
+					resource.TestCheckResourceAttrSet("scaleway_baremetal_bmc_access.main", "url"),
+					resource.TestCheckResourceAttrSet("scaleway_baremetal_bmc_access.main", "login"),
+					resource.TestCheckResourceAttrSet("scaleway_baremetal_bmc_access.main", "password"),
+					resource.TestCheckResourceAttrSet("scaleway_baremetal_bmc_access.main", "expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceScalewayBaremetalBMCAccessRead(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/baremetal/v1/zones/fr-par-2/servers/11111111-1111-1111-1111-111111111111/bmc-access":
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"url":"https://bmc.example.com","login":"admin","password":"secret","expires_at":%q}`, expiresAt.Format(time.RFC3339))))
+		case "/baremetal/v1/zones/fr-par-2/servers/22222222-2222-2222-2222-222222222222/bmc-access":
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"url":"https://bmc.example.com","login":"admin","password":"secret","expires_at":%q}`, time.Now().Add(-time.Hour).Format(time.RFC3339))))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"resource is not found","resource":"bmc_access","type":"not_found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := scw.NewClient(scw.WithoutAuth(), scw.WithAPIURL(server.URL))
+	require.NoError(t, err)
+	meta := &Meta{scwClient: client}
+
+	tests := []struct {
+		name       string
+		id         string
+		expectedID string
+	}{
+		{name: "open access", id: "fr-par-2/11111111-1111-1111-1111-111111111111", expectedID: "fr-par-2/11111111-1111-1111-1111-111111111111"},
+		{name: "expired access", id: "fr-par-2/22222222-2222-2222-2222-222222222222"},
+		{name: "closed access", id: "fr-par-2/33333333-3333-3333-3333-333333333333"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := resourceScalewayBaremetalBMCAccess().Data(nil)
+			d.SetId(tt.id)
+
+			diags := resourceScalewayBaremetalBMCAccessRead(context.Background(), d, meta)
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.expectedID, d.Id())
+			if tt.expectedID != "" {
+				assert.Equal(t, "https://bmc.example.com", d.Get("url"))
+				assert.Equal(t, "admin", d.Get("login"))
+				assert.Equal(t, "secret", d.Get("password"))
+				assert.Equal(t, "fr-par-2", d.Get("zone"))
+			}
+		})
+	}
+}
+
+func testAccCheckScalewayBaremetalBMCAccessExists(tt *TestTools, n string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(tt.Meta, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = baremetalAPI.GetBMCAccess(&baremetal.GetBMCAccessRequest{
+			Zone:     zonedID.Zone,
+			ServerID: zonedID.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScalewayBaremetalBMCAccessDestroy(tt *TestTools) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "scaleway_baremetal_bmc_access" {
+				continue
+			}
+
+			baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(tt.Meta, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = baremetalAPI.GetBMCAccess(&baremetal.GetBMCAccessRequest{
+				Zone:     zonedID.Zone,
+				ServerID: zonedID.ID,
+			})
+
+			// If no error resource still exist
+			if err == nil {
+				return fmt.Errorf("BMC access of server (%s) still exists", rs.Primary.ID)
+			}
+
+			// Unexpected api error we return it
+			if !is404Error(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
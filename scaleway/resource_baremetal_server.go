@@ -274,12 +274,12 @@ func resourceScalewayBaremetalServerIP() *schema.Resource {
 func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	baremetalAPI, zone, err := baremetalAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	baremetalPrivateNetworkAPI, _, err := baremetalPrivateNetworkAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	offerID := expandZonedID(d.Get("offer"))
@@ -289,7 +289,7 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 			Zone:      zone,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		offerID = newZonedID(zone, o.ID)
 	}
@@ -309,14 +309,14 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 		Tags:        expandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedID(server.Zone, server.ID).String())
 
 	_, err = waitForBaremetalServer(ctx, baremetalAPI, zone, server.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if !d.Get("install_config_afterward").(bool) {
@@ -332,12 +332,12 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 			ServicePassword: expandStringPtr(d.Get("service_password")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForBaremetalServerInstall(ctx, baremetalAPI, zone, server.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -345,7 +345,7 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 	if optionsExist {
 		opSpecs, err := expandBaremetalOptions(options)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		for i := range opSpecs {
 			_, err = baremetalAPI.AddOptionServer(&baremetal.AddOptionServerRequest{
@@ -355,7 +355,7 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 				ExpiresAt: opSpecs[i].ExpiresAt,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -373,12 +373,12 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 			scw.WithContext(ctx),
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForBaremetalServerPrivateNetwork(ctx, baremetalPrivateNetworkAPI, zone, baremetalPrivateNetwork.ServerPrivateNetworks[0].ServerID, d.Timeout(schema.TimeoutCreate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -388,12 +388,12 @@ func resourceScalewayBaremetalServerCreate(ctx context.Context, d *schema.Resour
 func resourceScalewayBaremetalServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	baremetalPrivateNetworkAPI, _, err := baremetalPrivateNetworkAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	server, err := baremetalAPI.GetServer(&baremetal.GetServerRequest{
@@ -405,7 +405,7 @@ func resourceScalewayBaremetalServerRead(ctx context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	offer, err := baremetalAPI.GetOffer(&baremetal.GetOfferRequest{
@@ -413,7 +413,7 @@ func resourceScalewayBaremetalServerRead(ctx context.Context, d *schema.Resource
 		OfferID: server.OfferID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var os *baremetal.OS
@@ -423,7 +423,7 @@ func resourceScalewayBaremetalServerRead(ctx context.Context, d *schema.Resource
 			OsID: server.Install.OsID,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -452,13 +452,13 @@ func resourceScalewayBaremetalServerRead(ctx context.Context, d *schema.Resource
 	listPrivateNetworks, err := baremetalPrivateNetworkAPI.ListServerPrivateNetworks(&baremetal.PrivateNetworkAPIListServerPrivateNetworksRequest{
 		Zone:     server.Zone,
 		ServerID: &server.ID,
-	})
+	}, scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to list server's private networks: %w", err))
+		return diagFromErr(fmt.Errorf("failed to list server's private networks: %w", err))
 	}
 	pnRegion, err := server.Zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("private_network", flattenBaremetalPrivateNetworks(pnRegion, listPrivateNetworks.ServerPrivateNetworks))
 
@@ -469,12 +469,12 @@ func resourceScalewayBaremetalServerRead(ctx context.Context, d *schema.Resource
 func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	baremetalPrivateNetworkAPI, zone, err := baremetalPrivateNetworkAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	server, err := baremetalAPI.GetServer(&baremetal.GetServerRequest{
@@ -482,7 +482,7 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 		ServerID: zonedID.ID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var serverGetOptionIDs []*baremetal.ServerOption
@@ -491,7 +491,7 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 	if d.HasChange("options") {
 		options, err := expandBaremetalOptions(d.Get("options"))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		optionsToDelete := baremetalCompareOptions(options, serverGetOptionIDs)
 		for i := range optionsToDelete {
@@ -501,13 +501,13 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 				OptionID: optionsToDelete[i].ID,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 
 		_, err = waitForBaremetalServerOptions(ctx, baremetalAPI, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutDelete))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		optionsToAdd := baremetalCompareOptions(serverGetOptionIDs, options)
@@ -519,7 +519,7 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 				ExpiresAt: optionsToAdd[i].ExpiresAt,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -538,12 +538,12 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 			scw.WithContext(ctx),
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForBaremetalServerPrivateNetwork(ctx, baremetalPrivateNetworkAPI, zone, baremetalPrivateNetwork.ServerPrivateNetworks[0].ServerID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -572,7 +572,7 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 	if hasChanged {
 		_, err = baremetalAPI.UpdateServer(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -593,12 +593,12 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 		}
 		err = baremetalInstallServer(ctx, d, baremetalAPI, installReq)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForBaremetalServerInstall(ctx, baremetalAPI, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -618,12 +618,12 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 			}
 			err = baremetalInstallServer(ctx, d, baremetalAPI, installReq)
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 
 			_, err = waitForBaremetalServerInstall(ctx, baremetalAPI, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -634,7 +634,7 @@ func resourceScalewayBaremetalServerUpdate(ctx context.Context, d *schema.Resour
 func resourceScalewayBaremetalServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	baremetalAPI, zonedID, err := baremetalAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = baremetalAPI.DeleteServer(&baremetal.DeleteServerRequest{
@@ -645,12 +645,12 @@ func resourceScalewayBaremetalServerDelete(ctx context.Context, d *schema.Resour
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForBaremetalServer(ctx, baremetalAPI, zonedID.Zone, zonedID.ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -669,7 +669,7 @@ func baremetalInstallAttributeMissing(field *baremetal.OSOSField, d *schema.Reso
 func validateInstallConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	baremetalAPI, zone, err := baremetalAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	os, err := baremetalAPI.GetOS(&baremetal.GetOSRequest{
@@ -677,7 +677,7 @@ func validateInstallConfig(ctx context.Context, d *schema.ResourceData, meta int
 		OsID: expandID(d.Get("os")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	diags := diag.Diagnostics(nil)
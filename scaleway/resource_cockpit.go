@@ -65,6 +65,25 @@ func resourceScalewayCockpit() *schema.Resource {
 					},
 				},
 			},
+			"push_url": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Push_url",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"push_metrics_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Push URL for metrics (Grafana Mimir)",
+						},
+						"push_logs_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Push URL for logs (Grafana Loki)",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -72,7 +91,7 @@ func resourceScalewayCockpit() *schema.Resource {
 func resourceScalewayCockpitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := cockpitAPI(meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	projectID := d.Get("project_id").(string)
@@ -81,27 +100,15 @@ func resourceScalewayCockpitCreate(ctx context.Context, d *schema.ResourceData,
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if targetPlanI, ok := d.GetOk("plan"); ok {
 		targetPlan := targetPlanI.(string)
 
-		plans, err := api.ListPlans(&cockpit.ListPlansRequest{}, scw.WithContext(ctx), scw.WithAllPages())
+		planID, err := getCockpitPlanID(ctx, api, targetPlan)
 		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		var planID string
-		for _, plan := range plans.Plans {
-			if plan.Name.String() == targetPlan || plan.ID == targetPlan {
-				planID = plan.ID
-				break
-			}
-		}
-
-		if planID == "" {
-			return diag.Errorf("plan %s not found", targetPlan)
+			return diagFromErr(err)
 		}
 
 		_, err = api.SelectPlan(&cockpit.SelectPlanRequest{
@@ -109,7 +116,7 @@ func resourceScalewayCockpitCreate(ctx context.Context, d *schema.ResourceData,
 			PlanID:    planID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -120,17 +127,18 @@ func resourceScalewayCockpitCreate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayCockpitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := cockpitAPI(meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := waitForCockpit(ctx, api, d.Id(), d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("project_id", res.ProjectID)
 	_ = d.Set("plan_id", res.Plan.ID)
 	_ = d.Set("endpoints", flattenCockpitEndpoints(res.Endpoints))
+	_ = d.Set("push_url", flattenCockpitPushURL(res.Endpoints))
 
 	return nil
 }
@@ -138,13 +146,13 @@ func resourceScalewayCockpitRead(ctx context.Context, d *schema.ResourceData, me
 func resourceScalewayCockpitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := cockpitAPI(meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	projectID := d.Id()
 	_, err = waitForCockpit(ctx, api, projectID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("plan") {
@@ -153,21 +161,9 @@ func resourceScalewayCockpitUpdate(ctx context.Context, d *schema.ResourceData,
 			targetPlan = targetPlanI.(string)
 		}
 
-		plans, err := api.ListPlans(&cockpit.ListPlansRequest{}, scw.WithContext(ctx), scw.WithAllPages())
+		planID, err := getCockpitPlanID(ctx, api, targetPlan)
 		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		var planID string
-		for _, plan := range plans.Plans {
-			if plan.Name.String() == targetPlan || plan.ID == targetPlan {
-				planID = plan.ID
-				break
-			}
-		}
-
-		if planID == "" {
-			return diag.Errorf("plan %s not found", targetPlan)
+			return diagFromErr(err)
 		}
 
 		_, err = api.SelectPlan(&cockpit.SelectPlanRequest{
@@ -175,7 +171,7 @@ func resourceScalewayCockpitUpdate(ctx context.Context, d *schema.ResourceData,
 			PlanID:    planID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -185,25 +181,25 @@ func resourceScalewayCockpitUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayCockpitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := cockpitAPI(meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForCockpit(ctx, api, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeactivateCockpit(&cockpit.DeactivateCockpitRequest{
 		ProjectID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForCockpit(ctx, api, d.Id(), d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
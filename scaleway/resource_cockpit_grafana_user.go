@@ -50,6 +50,11 @@ func resourceScalewayCockpitGrafanaUser() *schema.Resource {
 					cockpit.GrafanaUserRoleViewer.String(),
 				}, false),
 			},
+			"grafana_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The grafana URL the user can log in to",
+			},
 			"project_id": projectIDSchema(),
 		},
 	}
@@ -58,7 +63,7 @@ func resourceScalewayCockpitGrafanaUser() *schema.Resource {
 func resourceScalewayCockpitGrafanaUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, err := cockpitAPI(meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	projectID := d.Get("project_id").(string)
@@ -69,7 +74,7 @@ func resourceScalewayCockpitGrafanaUserCreate(ctx context.Context, d *schema.Res
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	grafanaUser, err := api.CreateGrafanaUser(&cockpit.CreateGrafanaUserRequest{
@@ -78,7 +83,7 @@ func resourceScalewayCockpitGrafanaUserCreate(ctx context.Context, d *schema.Res
 		Role:      role,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("password", grafanaUser.Password)
@@ -89,10 +94,10 @@ func resourceScalewayCockpitGrafanaUserCreate(ctx context.Context, d *schema.Res
 func resourceScalewayCockpitGrafanaUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, projectID, grafanaUserID, err := cockpitAPIGrafanaUserID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	_, err = api.WaitForCockpit(&cockpit.WaitForCockpitRequest{
+	cockpitRes, err := api.WaitForCockpit(&cockpit.WaitForCockpitRequest{
 		ProjectID: projectID,
 	}, scw.WithContext(ctx))
 	if err != nil {
@@ -100,7 +105,7 @@ func resourceScalewayCockpitGrafanaUserRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := api.ListGrafanaUsers(&cockpit.ListGrafanaUsersRequest{
@@ -111,7 +116,7 @@ func resourceScalewayCockpitGrafanaUserRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var grafanaUser *cockpit.GrafanaUser
@@ -129,6 +134,9 @@ func resourceScalewayCockpitGrafanaUserRead(ctx context.Context, d *schema.Resou
 
 	_ = d.Set("login", grafanaUser.Login)
 	_ = d.Set("role", grafanaUser.Role)
+	if cockpitRes.Endpoints != nil {
+		_ = d.Set("grafana_url", cockpitRes.Endpoints.GrafanaURL)
+	}
 	_ = d.Set("project_id", projectID)
 
 	return nil
@@ -137,7 +145,7 @@ func resourceScalewayCockpitGrafanaUserRead(ctx context.Context, d *schema.Resou
 func resourceScalewayCockpitGrafanaUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, projectID, grafanaUserID, err := cockpitAPIGrafanaUserID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.WaitForCockpit(&cockpit.WaitForCockpitRequest{
@@ -147,7 +155,7 @@ func resourceScalewayCockpitGrafanaUserDelete(ctx context.Context, d *schema.Res
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = api.DeleteGrafanaUser(&cockpit.DeleteGrafanaUserRequest{
@@ -158,7 +166,7 @@ func resourceScalewayCockpitGrafanaUserDelete(ctx context.Context, d *schema.Res
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -20,6 +20,7 @@ func resourceScalewayContainer() *schema.Resource {
 		ReadContext:   resourceScalewayContainerRead,
 		UpdateContext: resourceScalewayContainerUpdate,
 		DeleteContext: resourceScalewayContainerDelete,
+		CustomizeDiff: customizeDiffContainerRegistryImageDigest,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -123,6 +124,17 @@ func resourceScalewayContainer() *schema.Resource {
 				RequiredWith: []string{"registry_image"},
 				Description:  "The sha256 of your source registry image, changing it will re-apply the deployment. Can be any string",
 			},
+			"deploy_on_digest_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Resolve the registry image tag to a digest at plan time and redeploy the container when it changes",
+			},
+			"registry_image_digest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The digest of the registry image tag, resolved when deploy_on_digest_change is enabled",
+			},
 			"max_concurrency": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -192,7 +204,7 @@ func resourceScalewayContainer() *schema.Resource {
 func resourceScalewayContainerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := containerAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	namespaceID := expandID(d.Get("namespace_id").(string))
@@ -204,7 +216,7 @@ func resourceScalewayContainerCreate(ctx context.Context, d *schema.ResourceData
 
 	req, err := setCreateContainerRequest(d, region)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := api.CreateContainer(req, scw.WithContext(ctx))
@@ -227,7 +239,7 @@ func resourceScalewayContainerCreate(ctx context.Context, d *schema.ResourceData
 		}
 		_, err = api.UpdateContainer(reqUpdate, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForContainer(ctx, api, res.ID, region, d.Timeout(schema.TimeoutCreate))
@@ -244,7 +256,7 @@ func resourceScalewayContainerCreate(ctx context.Context, d *schema.ResourceData
 func resourceScalewayContainerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, containerID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	co, err := waitForContainer(ctx, api, containerID, region, d.Timeout(schema.TimeoutCreate))
@@ -275,6 +287,7 @@ func resourceScalewayContainerRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("cron_status", co.Status.String())
 	_ = d.Set("port", int(co.Port))
 	_ = d.Set("deploy", scw.BoolPtr(*expandBoolPtr(d.Get("deploy"))))
+	_ = d.Set("deploy_on_digest_change", d.Get("deploy_on_digest_change").(bool))
 	_ = d.Set("http_option", co.HTTPOption)
 	_ = d.Set("region", co.Region.String())
 
@@ -284,7 +297,7 @@ func resourceScalewayContainerRead(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayContainerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, containerID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	namespaceID := d.Get("namespace_id")
@@ -367,19 +380,20 @@ func resourceScalewayContainerUpdate(ctx context.Context, d *schema.ResourceData
 		req.Redeploy = expandBoolPtr(d.Get("deploy"))
 	}
 
-	imageHasChanged := d.HasChanges("registry_sha256")
+	imageHasChanged := d.HasChanges("registry_sha256") ||
+		d.Get("deploy_on_digest_change").(bool) && d.HasChange("registry_image_digest")
 	if imageHasChanged {
 		req.Redeploy = &imageHasChanged
 	}
 
 	con, err := api.UpdateContainer(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForContainer(ctx, api, con.ID, region, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayContainerRead(ctx, d, meta)
@@ -388,13 +402,13 @@ func resourceScalewayContainerUpdate(ctx context.Context, d *schema.ResourceData
 func resourceScalewayContainerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, containerID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// check for container state
 	_, err = waitForContainer(ctx, api, containerID, region, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// delete container
@@ -403,7 +417,7 @@ func resourceScalewayContainerDelete(ctx context.Context, d *schema.ResourceData
 		ContainerID: containerID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
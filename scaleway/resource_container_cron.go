@@ -59,12 +59,12 @@ func resourceScalewayContainerCron() *schema.Resource {
 func resourceScalewayContainerCronCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := containerAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	jsonObj, err := scw.DecodeJSONObject(d.Get("args").(string), scw.NoEscape)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	containerID := expandID(d.Get("container_id").(string))
@@ -78,13 +78,13 @@ func resourceScalewayContainerCronCreate(ctx context.Context, d *schema.Resource
 
 	res, err := api.CreateCron(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("[INFO] Submitted new cron job: %#v", res.Schedule))
 	_, err = waitForContainerCron(ctx, api, res.ID, region, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	tflog.Info(ctx, "[INFO] cron job ready")
 
@@ -96,7 +96,7 @@ func resourceScalewayContainerCronCreate(ctx context.Context, d *schema.Resource
 func resourceScalewayContainerCronRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, containerCronID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	cron, err := waitForContainerCron(ctx, api, containerCronID, region, d.Timeout(schema.TimeoutRead))
@@ -105,12 +105,12 @@ func resourceScalewayContainerCronRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	args, err := scw.EncodeJSONObject(*cron.Args, scw.NoEscape)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("container_id", newRegionalID(region, cron.ContainerID).String())
@@ -124,7 +124,7 @@ func resourceScalewayContainerCronRead(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayContainerCronUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, containerCronID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &container.UpdateCronRequest{
@@ -142,7 +142,7 @@ func resourceScalewayContainerCronUpdate(ctx context.Context, d *schema.Resource
 	if d.HasChange("args") {
 		jsonObj, err := scw.DecodeJSONObject(d.Get("args").(string), scw.NoEscape)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		shouldUpdate = true
 		req.Args = &jsonObj
@@ -151,13 +151,13 @@ func resourceScalewayContainerCronUpdate(ctx context.Context, d *schema.Resource
 	if shouldUpdate {
 		cron, err := api.UpdateCron(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		tflog.Info(ctx, fmt.Sprintf("[INFO] Updated cron job: %#v", req.Schedule))
 		_, err = waitForContainerCron(ctx, api, cron.ID, region, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	tflog.Info(ctx, "[INFO] cron job ready")
@@ -168,12 +168,12 @@ func resourceScalewayContainerCronUpdate(ctx context.Context, d *schema.Resource
 func resourceScalewayContainerCronDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, containerCronID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForContainerCron(ctx, api, containerCronID, region, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteCron(&container.DeleteCronRequest{
@@ -181,7 +181,7 @@ func resourceScalewayContainerCronDelete(ctx context.Context, d *schema.Resource
 		CronID: containerCronID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	tflog.Info(ctx, "[INFO] cron job deleted")
 	return nil
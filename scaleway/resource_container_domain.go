@@ -54,7 +54,7 @@ func resourceScalewayContainerDomain() *schema.Resource {
 func resourceScalewayContainerDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := containerAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	hostname := d.Get("hostname").(string)
@@ -62,7 +62,7 @@ func resourceScalewayContainerDomainCreate(ctx context.Context, d *schema.Resour
 
 	_, err = waitForContainer(ctx, api, containerID, region, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &container.CreateDomainRequest{
@@ -73,12 +73,12 @@ func resourceScalewayContainerDomainCreate(ctx context.Context, d *schema.Resour
 
 	domain, err := retryCreateContainerDomain(ctx, api, req, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForContainerDomain(ctx, api, domain.ID, region, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, domain.ID))
@@ -89,7 +89,7 @@ func resourceScalewayContainerDomainCreate(ctx context.Context, d *schema.Resour
 func resourceScalewayContainerDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, domainID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	domain, err := waitForContainerDomain(ctx, api, domainID, region, d.Timeout(schema.TimeoutCreate))
@@ -98,7 +98,7 @@ func resourceScalewayContainerDomainRead(ctx context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("hostname", domain.Hostname)
@@ -112,7 +112,7 @@ func resourceScalewayContainerDomainRead(ctx context.Context, d *schema.Resource
 func resourceScalewayContainerDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, domainID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForContainerDomain(ctx, api, domainID, region, d.Timeout(schema.TimeoutUpdate))
@@ -121,7 +121,7 @@ func resourceScalewayContainerDomainDelete(ctx context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteDomain(&container.DeleteDomainRequest{
@@ -129,7 +129,7 @@ func resourceScalewayContainerDomainDelete(ctx context.Context, d *schema.Resour
 		DomainID: domainID,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -18,7 +18,7 @@ func resourceScalewayContainerNamespace() *schema.Resource {
 		UpdateContext: resourceScalewayContainerNamespaceUpdate,
 		DeleteContext: resourceScalewayContainerNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findContainerNamespaceIDByName),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultContainerNamespaceTimeout),
@@ -89,7 +89,7 @@ func resourceScalewayContainerNamespace() *schema.Resource {
 func resourceScalewayContainerNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := containerAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ns, err := api.CreateNamespace(&container.CreateNamespaceRequest{
@@ -101,14 +101,14 @@ func resourceScalewayContainerNamespaceCreate(ctx context.Context, d *schema.Res
 		Region:                     region,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, ns.ID))
 
 	_, err = waitForContainerNamespace(ctx, api, region, ns.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayContainerNamespaceRead(ctx, d, meta)
@@ -117,7 +117,7 @@ func resourceScalewayContainerNamespaceCreate(ctx context.Context, d *schema.Res
 func resourceScalewayContainerNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ns, err := waitForContainerNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -126,7 +126,7 @@ func resourceScalewayContainerNamespaceRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("description", flattenStringPtr(ns.Description))
@@ -144,12 +144,12 @@ func resourceScalewayContainerNamespaceRead(ctx context.Context, d *schema.Resou
 func resourceScalewayContainerNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ns, err := waitForContainerNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &container.UpdateNamespaceRequest{
@@ -170,7 +170,7 @@ func resourceScalewayContainerNamespaceUpdate(ctx context.Context, d *schema.Res
 	}
 
 	if _, err := api.UpdateNamespace(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayContainerNamespaceRead(ctx, d, meta)
@@ -179,7 +179,7 @@ func resourceScalewayContainerNamespaceUpdate(ctx context.Context, d *schema.Res
 func resourceScalewayContainerNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForContainerNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -188,7 +188,7 @@ func resourceScalewayContainerNamespaceDelete(ctx context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteNamespace(&container.DeleteNamespaceRequest{
@@ -196,12 +196,12 @@ func resourceScalewayContainerNamespaceDelete(ctx context.Context, d *schema.Res
 		NamespaceID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForContainerNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -209,7 +209,7 @@ func resourceScalewayContainerNamespaceDelete(ctx context.Context, d *schema.Res
 	if destroy := d.Get("destroy_registry"); destroy != nil && destroy == true {
 		registryAPI, region, err := registryAPIWithRegion(d, meta)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		registryID := d.Get("registry_namespace_id").(string)
@@ -219,11 +219,11 @@ func resourceScalewayContainerNamespaceDelete(ctx context.Context, d *schema.Res
 			NamespaceID: registryID,
 		})
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		_, err = waitForRegistryNamespace(ctx, registryAPI, region, registryID, d.Timeout(schema.TimeoutDelete))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -60,7 +60,7 @@ func resourceScalewayContainerToken() *schema.Resource {
 func resourceScalewayContainerTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := containerAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	token, err := api.CreateToken(&container.CreateTokenRequest{
@@ -71,7 +71,7 @@ func resourceScalewayContainerTokenCreate(ctx context.Context, d *schema.Resourc
 		ExpiresAt:   expandTimePtr(d.Get("expires_at")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, token.ID))
@@ -84,7 +84,7 @@ func resourceScalewayContainerTokenCreate(ctx context.Context, d *schema.Resourc
 func resourceScalewayContainerTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, ID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	token, err := api.GetToken(&container.GetTokenRequest{
 		Region:  region,
@@ -95,7 +95,7 @@ func resourceScalewayContainerTokenRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("container_id", flattenStringPtr(token.ContainerID))
@@ -109,7 +109,7 @@ func resourceScalewayContainerTokenRead(ctx context.Context, d *schema.ResourceD
 func resourceScalewayContainerTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, ID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteToken(&container.DeleteTokenRequest{
@@ -117,7 +117,7 @@ func resourceScalewayContainerTokenDelete(ctx context.Context, d *schema.Resourc
 		TokenID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
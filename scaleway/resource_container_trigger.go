@@ -88,7 +88,7 @@ func resourceScalewayContainerTrigger() *schema.Resource {
 func resourceScalewayContainerTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := containerAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &container.CreateTriggerRequest{
@@ -101,7 +101,7 @@ func resourceScalewayContainerTriggerCreate(ctx context.Context, d *schema.Resou
 	if scwSqs, isScwSqs := d.GetOk("sqs.0"); isScwSqs {
 		err := completeContainerTriggerMnqSqsCreationConfig(scwSqs, d, meta, region)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to complete sqs config: %w", err))
+			return diagFromErr(fmt.Errorf("failed to complete sqs config: %w", err))
 		}
 
 		_ = d.Set("sqs", []any{scwSqs})
@@ -110,14 +110,14 @@ func resourceScalewayContainerTriggerCreate(ctx context.Context, d *schema.Resou
 
 	trigger, err := api.CreateTrigger(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, trigger.ID))
 
 	_, err = waitForContainerTrigger(ctx, api, region, trigger.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayContainerTriggerRead(ctx, d, meta)
@@ -126,7 +126,7 @@ func resourceScalewayContainerTriggerCreate(ctx context.Context, d *schema.Resou
 func resourceScalewayContainerTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	trigger, err := waitForContainerTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -135,7 +135,7 @@ func resourceScalewayContainerTriggerRead(ctx context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", trigger.Name)
@@ -161,7 +161,7 @@ func resourceScalewayContainerTriggerRead(ctx context.Context, d *schema.Resourc
 func resourceScalewayContainerTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	trigger, err := waitForContainerTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -170,7 +170,7 @@ func resourceScalewayContainerTriggerUpdate(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &container.UpdateTriggerRequest{
@@ -187,7 +187,7 @@ func resourceScalewayContainerTriggerUpdate(ctx context.Context, d *schema.Resou
 	}
 
 	if _, err := api.UpdateTrigger(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayContainerTriggerRead(ctx, d, meta)
@@ -196,12 +196,12 @@ func resourceScalewayContainerTriggerUpdate(ctx context.Context, d *schema.Resou
 func resourceScalewayContainerTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForContainerTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteTrigger(&container.DeleteTriggerRequest{
@@ -209,12 +209,12 @@ func resourceScalewayContainerTriggerDelete(ctx context.Context, d *schema.Resou
 		TriggerID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForContainerTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
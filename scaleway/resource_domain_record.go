@@ -272,12 +272,12 @@ func resourceScalewayDomainRecordCreate(ctx context.Context, d *schema.ResourceD
 		ReturnAllRecords: scw.BoolPtr(false),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	record, err = waitForDNSRecordExist(ctx, domainAPI, dnsZone, record.Name, record.Type, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("DNS ZONE domain: %s record: %s, type: %s",
@@ -291,12 +291,12 @@ func resourceScalewayDomainRecordCreate(ctx context.Context, d *schema.ResourceD
 		Type:    recordType,
 	}, scw.WithAllPages(), scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	currentRecord, err := getRecordFromTypeAndData(recordType, recordData, dnsZoneData.Records)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	recordID := fmt.Sprintf("%s/%s", dnsZone, currentRecord.ID)
@@ -319,7 +319,7 @@ func resourceScalewayDomainRecordRead(ctx context.Context, d *schema.ResourceDat
 	if strings.Contains(d.Id(), "/") {
 		tab := strings.Split(d.Id(), "/")
 		if len(tab) != 2 {
-			return diag.FromErr(fmt.Errorf("cant parse record id: %s", d.Id()))
+			return diagFromErr(fmt.Errorf("cant parse record id: %s", d.Id()))
 		}
 
 		dnsZone = tab[0]
@@ -333,7 +333,7 @@ func resourceScalewayDomainRecordRead(ctx context.Context, d *schema.ResourceDat
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		if len(res.Records) > 0 {
@@ -344,11 +344,11 @@ func resourceScalewayDomainRecordRead(ctx context.Context, d *schema.ResourceDat
 
 		recordTypeRaw, recordTypeExist := d.GetOk("type")
 		if !recordTypeExist {
-			return diag.FromErr(fmt.Errorf("record type not found"))
+			return diagFromErr(fmt.Errorf("record type not found"))
 		}
 		recordType := domain.RecordType(recordTypeRaw.(string))
 		if recordType == domain.RecordTypeUnknown {
-			return diag.FromErr(fmt.Errorf("record type unknow"))
+			return diagFromErr(fmt.Errorf("record type unknow"))
 		}
 
 		idRecord := expandID(d.Id())
@@ -363,7 +363,7 @@ func resourceScalewayDomainRecordRead(ctx context.Context, d *schema.ResourceDat
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		if len(res.Records) > 0 {
@@ -382,7 +382,7 @@ func resourceScalewayDomainRecordRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// get the default first record
@@ -479,12 +479,12 @@ func resourceScalewayDomainRecordUpdate(ctx context.Context, d *schema.ResourceD
 	if hasChange || d.HasChanges("dns_zone", "keep_empty_zone") {
 		_, err := domainAPI.UpdateDNSZoneRecords(req)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForDNSRecordExist(ctx, domainAPI, d.Get("dns_zone").(string), record.Name, record.Type, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -507,7 +507,7 @@ func resourceScalewayDomainRecordDelete(ctx context.Context, d *schema.ResourceD
 		ReturnAllRecords: scw.BoolPtr(false),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.SetId("")
 
@@ -518,12 +518,12 @@ func resourceScalewayDomainRecordDelete(ctx context.Context, d *schema.ResourceD
 
 	res, err := domainAPI.ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
 		DNSZone: d.Get("dns_zone").(string),
-	})
+	}, scw.WithAllPages())
 	if err != nil {
 		if is404Error(err) || is403Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	for _, r := range res.Records {
@@ -539,7 +539,7 @@ func resourceScalewayDomainRecordDelete(ctx context.Context, d *schema.ResourceD
 		if is404Error(err) || is403Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = domainAPI.DeleteDNSZone(&domain.DeleteDNSZoneRequest{
@@ -551,7 +551,7 @@ func resourceScalewayDomainRecordDelete(ctx context.Context, d *schema.ResourceD
 		if is404Error(err) || is403Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -90,9 +90,9 @@ func resourceScalewayDomainZoneCreate(ctx context.Context, d *schema.ResourceDat
 	zones, err := domainAPI.ListDNSZones(&domain.ListDNSZonesRequest{
 		ProjectID: expandStringPtr(d.Get("project_id")),
 		DNSZone:   zoneName,
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	for i := range zones.DNSZones {
@@ -115,7 +115,7 @@ func resourceScalewayDomainZoneCreate(ctx context.Context, d *schema.ResourceDat
 		if is409Error(err) {
 			return resourceScalewayDomainZoneRead(ctx, d, meta)
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s.%s", dnsZone.Subdomain, dnsZone.Domain))
 
@@ -130,21 +130,21 @@ func resourceScalewayDomainZoneRead(ctx context.Context, d *schema.ResourceData,
 	zones, err := domainAPI.ListDNSZones(&domain.ListDNSZonesRequest{
 		ProjectID: expandStringPtr(d.Get("project_id")),
 		DNSZone:   d.Id(),
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
 		if is404Error(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if len(zones.DNSZones) == 0 {
-		return diag.FromErr(fmt.Errorf("no zone found with the name %s", d.Id()))
+		return diagFromErr(fmt.Errorf("no zone found with the name %s", d.Id()))
 	}
 
 	if len(zones.DNSZones) > 1 {
-		return diag.FromErr(fmt.Errorf("%d zone found with the same name %s", len(zones.DNSZones), d.Id()))
+		return diagFromErr(fmt.Errorf("%d zone found with the same name %s", len(zones.DNSZones), d.Id()))
 	}
 
 	zone = zones.DNSZones[0]
@@ -172,7 +172,7 @@ func resourceScalewayDomainZoneUpdate(ctx context.Context, d *schema.ResourceDat
 			NewDNSZone: scw.StringPtr(d.Get("subdomain").(string)),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	return resourceScalewayDomainZoneRead(ctx, d, meta)
@@ -186,7 +186,7 @@ func resourceScalewayDomainZoneDelete(ctx context.Context, d *schema.ResourceDat
 		if is404Error(err) || is403Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = domainAPI.DeleteDNSZone(&domain.DeleteDNSZoneRequest{
@@ -195,7 +195,7 @@ func resourceScalewayDomainZoneDelete(ctx context.Context, d *schema.ResourceDat
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) && !is403Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
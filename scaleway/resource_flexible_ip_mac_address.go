@@ -84,13 +84,13 @@ func resourceScalewayFlexibleIPMACAddress() *schema.Resource {
 func resourceScalewayFlexibleIPMACCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	fipID := expandID(d.Get("flexible_ip_id"))
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, fipID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := fipAPI.GenerateMACAddr(&flexibleip.GenerateMACAddrRequest{
@@ -99,7 +99,7 @@ func resourceScalewayFlexibleIPMACCreate(ctx context.Context, d *schema.Resource
 		MacType: flexibleip.MACAddressType(d.Get("type").(string)),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if res.MacAddress != nil {
@@ -108,7 +108,7 @@ func resourceScalewayFlexibleIPMACCreate(ctx context.Context, d *schema.Resource
 
 	fip, err := waitFlexibleIP(ctx, fipAPI, zone, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	duplicateIDs, duplicateIDsExist := d.GetOk("flexible_ip_ids_to_duplicate")
@@ -121,11 +121,11 @@ func resourceScalewayFlexibleIPMACCreate(ctx context.Context, d *schema.Resource
 				DuplicateFromFipID: fip.ID,
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			_, err = waitFlexibleIP(ctx, fipAPI, zone, expandID(dupID), d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -136,7 +136,7 @@ func resourceScalewayFlexibleIPMACCreate(ctx context.Context, d *schema.Resource
 func resourceScalewayFlexibleIPMACRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	fip, err := fipAPI.GetFlexibleIP(&flexibleip.GetFlexibleIPRequest{
@@ -149,7 +149,7 @@ func resourceScalewayFlexibleIPMACRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("flexible_ip_id", newZonedIDString(zone, fip.ID))
@@ -169,12 +169,12 @@ func resourceScalewayFlexibleIPMACRead(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayFlexibleIPMACUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	flexibleIP, err := waitFlexibleIP(ctx, fipAPI, zone, expandID(d.Get("flexible_ip_id")), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("flexible_ip_id") {
@@ -188,12 +188,12 @@ func resourceScalewayFlexibleIPMACUpdate(ctx context.Context, d *schema.Resource
 			DstFipID: newFipID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		flexibleIP, err = waitFlexibleIP(ctx, fipAPI, zone, res.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -207,7 +207,7 @@ func resourceScalewayFlexibleIPMACUpdate(ctx context.Context, d *schema.Resource
 			if !sliceContainsString(oldIDs, newID) {
 				_, err = waitFlexibleIP(ctx, fipAPI, zone, expandID(newID), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 				_, err := fipAPI.DuplicateMACAddr(&flexibleip.DuplicateMACAddrRequest{
 					Zone:               zone,
@@ -215,11 +215,11 @@ func resourceScalewayFlexibleIPMACUpdate(ctx context.Context, d *schema.Resource
 					DuplicateFromFipID: flexibleIP.ID,
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 				_, err = waitFlexibleIP(ctx, fipAPI, zone, expandID(newID), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 			}
 		}
@@ -231,11 +231,11 @@ func resourceScalewayFlexibleIPMACUpdate(ctx context.Context, d *schema.Resource
 					FipID: expandID(oldID),
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 				_, err = waitFlexibleIP(ctx, fipAPI, zone, expandID(oldID), d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 			}
 		}
@@ -243,7 +243,7 @@ func resourceScalewayFlexibleIPMACUpdate(ctx context.Context, d *schema.Resource
 
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, flexibleIP.ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayFlexibleIPMACRead(ctx, d, meta)
@@ -252,12 +252,12 @@ func resourceScalewayFlexibleIPMACUpdate(ctx context.Context, d *schema.Resource
 func resourceScalewayFlexibleIPMACDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	fipAPI, zone, err := fipAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	flexibleIP, err := waitFlexibleIP(ctx, fipAPI, zone, expandID(d.Get("flexible_ip_id")), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = fipAPI.DeleteMACAddr(&flexibleip.DeleteMACAddrRequest{
@@ -265,12 +265,12 @@ func resourceScalewayFlexibleIPMACDelete(ctx context.Context, d *schema.Resource
 		Zone:  zone,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) && !is403Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitFlexibleIP(ctx, fipAPI, zone, expandID(d.Get("flexible_ip_id")), d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -162,12 +162,12 @@ func resourceScalewayFunction() *schema.Resource {
 func resourceScalewayFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, namespace, err := parseRegionalID(d.Get("namespace_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &function.CreateFunctionRequest{
@@ -192,7 +192,7 @@ func resourceScalewayFunctionCreate(ctx context.Context, d *schema.ResourceData,
 
 	f, err := api.CreateFunction(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var diags diag.Diagnostics
@@ -240,7 +240,7 @@ func resourceScalewayFunctionCreate(ctx context.Context, d *schema.ResourceData,
 
 	_, err = waitForFunction(ctx, api, region, f.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return append(diags, resourceScalewayFunctionRead(ctx, d, meta)...)
@@ -249,7 +249,7 @@ func resourceScalewayFunctionCreate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	f, err := waitForFunction(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -258,7 +258,7 @@ func resourceScalewayFunctionRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var diags diag.Diagnostics
@@ -300,7 +300,7 @@ func resourceScalewayFunctionRead(ctx context.Context, d *schema.ResourceData, m
 func resourceScalewayFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	f, err := waitForFunction(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -309,7 +309,7 @@ func resourceScalewayFunctionUpdate(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &function.UpdateFunctionRequest{
@@ -371,7 +371,7 @@ func resourceScalewayFunctionUpdate(ctx context.Context, d *schema.ResourceData,
 	if updated {
 		_, err = api.UpdateFunction(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		// Function is not in transit state at this point, api did not update it instantly when processing UpdateFunction
 		// We sleep so api has time to change resource to a transit state
@@ -385,7 +385,7 @@ func resourceScalewayFunctionUpdate(ctx context.Context, d *schema.ResourceData,
 	if zipHasChanged {
 		err = functionUpload(ctx, meta, api, region, f.ID, d.Get("zip_file").(string))
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to upload function: %w", err))
+			return diagFromErr(fmt.Errorf("failed to upload function: %w", err))
 		}
 	}
 
@@ -396,7 +396,7 @@ func resourceScalewayFunctionUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 		err = functionDeploy(ctx, api, region, f.ID)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -406,7 +406,7 @@ func resourceScalewayFunctionUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForFunction(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -420,7 +420,7 @@ func resourceScalewayFunctionDelete(ctx context.Context, d *schema.ResourceData,
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -57,13 +57,13 @@ func resourceScalewayFunctionCron() *schema.Resource {
 func resourceScalewayFunctionCronCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	functionID := expandID(d.Get("function_id").(string))
 	f, err := waitForFunction(ctx, api, region, functionID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &function.CreateCronRequest{
@@ -75,19 +75,19 @@ func resourceScalewayFunctionCronCreate(ctx context.Context, d *schema.ResourceD
 	if args, ok := d.GetOk("args"); ok {
 		jsonObj, err := scw.DecodeJSONObject(args.(string), scw.NoEscape)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		request.Args = &jsonObj
 	}
 
 	cron, err := api.CreateCron(request, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForFunctionCron(ctx, api, region, cron.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, cron.ID))
@@ -98,7 +98,7 @@ func resourceScalewayFunctionCronCreate(ctx context.Context, d *schema.ResourceD
 func resourceScalewayFunctionCronRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	cron, err := waitForFunctionCron(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -107,7 +107,7 @@ func resourceScalewayFunctionCronRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("function_id", newRegionalID(region, cron.FunctionID).String())
@@ -115,7 +115,7 @@ func resourceScalewayFunctionCronRead(ctx context.Context, d *schema.ResourceDat
 
 	args, err := scw.EncodeJSONObject(*cron.Args, scw.NoEscape)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("args", args)
@@ -127,12 +127,12 @@ func resourceScalewayFunctionCronRead(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayFunctionCronUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	cron, err := waitForFunctionCron(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &function.UpdateCronRequest{
@@ -149,7 +149,7 @@ func resourceScalewayFunctionCronUpdate(ctx context.Context, d *schema.ResourceD
 	if d.HasChange("args") {
 		jsonObj, err := scw.DecodeJSONObject(d.Get("args").(string), scw.NoEscape)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		shouldUpdate = true
 		req.Args = &jsonObj
@@ -158,7 +158,7 @@ func resourceScalewayFunctionCronUpdate(ctx context.Context, d *schema.ResourceD
 	if shouldUpdate {
 		_, err = api.UpdateCron(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -168,7 +168,7 @@ func resourceScalewayFunctionCronUpdate(ctx context.Context, d *schema.ResourceD
 func resourceScalewayFunctionCronDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	cron, err := waitForFunctionCron(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -177,7 +177,7 @@ func resourceScalewayFunctionCronDelete(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteCron(&function.DeleteCronRequest{
@@ -186,7 +186,7 @@ func resourceScalewayFunctionCronDelete(ctx context.Context, d *schema.ResourceD
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
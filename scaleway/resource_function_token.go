@@ -61,7 +61,7 @@ func resourceScalewayFunctionToken() *schema.Resource {
 func resourceScalewayFunctionTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	token, err := api.CreateToken(&function.CreateTokenRequest{
@@ -72,7 +72,7 @@ func resourceScalewayFunctionTokenCreate(ctx context.Context, d *schema.Resource
 		ExpiresAt:   expandTimePtr(d.Get("expires_at")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, token.ID))
@@ -85,7 +85,7 @@ func resourceScalewayFunctionTokenCreate(ctx context.Context, d *schema.Resource
 func resourceScalewayFunctionTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, ID, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	token, err := api.GetToken(&function.GetTokenRequest{
 		Region:  region,
@@ -96,7 +96,7 @@ func resourceScalewayFunctionTokenRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("function_id", flattenStringPtr(token.FunctionID))
@@ -110,7 +110,7 @@ func resourceScalewayFunctionTokenRead(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayFunctionTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, ID, err := containerAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteToken(&container.DeleteTokenRequest{
@@ -118,7 +118,7 @@ func resourceScalewayFunctionTokenDelete(ctx context.Context, d *schema.Resource
 		TokenID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId("")
@@ -46,11 +46,12 @@ func resourceScalewayFunctionTrigger() *schema.Resource {
 				Description: "The trigger description",
 			},
 			"sqs": {
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Description: "Config for sqs based trigger using scaleway mnq",
-				Optional:    true,
-				ForceNew:    true,
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Description:   "Config for sqs based trigger using scaleway mnq",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"nats"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"namespace_id": {
@@ -79,6 +80,41 @@ func resourceScalewayFunctionTrigger() *schema.Resource {
 					},
 				},
 			},
+			"nats": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Description:   "Config for nats based trigger using scaleway mnq",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"sqs"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace_id": {
+							Required:         true,
+							Type:             schema.TypeString,
+							Description:      "ID of the mnq namespace",
+							DiffSuppressFunc: diffSuppressFuncLocality,
+						},
+						"subject": {
+							Required:    true,
+							Type:        schema.TypeString,
+							Description: "Subject to listen to",
+						},
+						"project_id": {
+							Computed:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+							Description: "Project ID of the project where the mnq nats exists, defaults to provider project_id",
+						},
+						"region": {
+							Computed:    true,
+							Optional:    true,
+							Type:        schema.TypeString,
+							Description: "Region where the mnq nats exists, defaults to function's region",
+						},
+					},
+				},
+			},
 			"region": regionSchema(),
 		},
 		CustomizeDiff: customizeDiffLocalityCheck("function_id"),
@@ -88,7 +124,7 @@ func resourceScalewayFunctionTrigger() *schema.Resource {
 func resourceScalewayFunctionTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := functionAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &function.CreateTriggerRequest{
@@ -99,25 +135,35 @@ func resourceScalewayFunctionTriggerCreate(ctx context.Context, d *schema.Resour
 	}
 
 	if scwSqs, isScwSqs := d.GetOk("sqs.0"); isScwSqs {
-		err := completeFunctionTriggerMnqSqsCreationConfig(scwSqs, d, meta, region)
+		err := completeFunctionTriggerMnqCreationConfig(scwSqs, d, meta, region)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to complete sqs config: %w", err))
+			return diagFromErr(fmt.Errorf("failed to complete sqs config: %w", err))
 		}
 
 		_ = d.Set("sqs", []any{scwSqs})
 		req.ScwSqsConfig = expandFunctionTriggerMnqSqsCreationConfig(scwSqs)
 	}
 
+	if scwNats, isScwNats := d.GetOk("nats.0"); isScwNats {
+		err := completeFunctionTriggerMnqCreationConfig(scwNats, d, meta, region)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to complete nats config: %w", err))
+		}
+
+		_ = d.Set("nats", []any{scwNats})
+		req.ScwNatsConfig = expandFunctionTriggerMnqNatsCreationConfig(scwNats)
+	}
+
 	trigger, err := api.CreateTrigger(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, trigger.ID))
 
 	_, err = waitForFunctionTrigger(ctx, api, region, trigger.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayFunctionTriggerRead(ctx, d, meta)
@@ -126,7 +172,7 @@ func resourceScalewayFunctionTriggerCreate(ctx context.Context, d *schema.Resour
 func resourceScalewayFunctionTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	trigger, err := waitForFunctionTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -135,7 +181,7 @@ func resourceScalewayFunctionTriggerRead(ctx context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", trigger.Name)
@@ -161,7 +207,7 @@ func resourceScalewayFunctionTriggerRead(ctx context.Context, d *schema.Resource
 func resourceScalewayFunctionTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	trigger, err := waitForFunctionTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -170,7 +216,7 @@ func resourceScalewayFunctionTriggerUpdate(ctx context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &function.UpdateTriggerRequest{
@@ -187,7 +233,7 @@ func resourceScalewayFunctionTriggerUpdate(ctx context.Context, d *schema.Resour
 	}
 
 	if _, err := api.UpdateTrigger(req, scw.WithContext(ctx)); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayFunctionTriggerRead(ctx, d, meta)
@@ -196,12 +242,12 @@ func resourceScalewayFunctionTriggerUpdate(ctx context.Context, d *schema.Resour
 func resourceScalewayFunctionTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := functionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForFunctionTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteTrigger(&function.DeleteTriggerRequest{
@@ -209,12 +255,12 @@ func resourceScalewayFunctionTriggerDelete(ctx context.Context, d *schema.Resour
 		TriggerID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForFunctionTrigger(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
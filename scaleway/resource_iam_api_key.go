@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -79,8 +80,46 @@ func resourceScalewayIamAPIKey() *schema.Resource {
 				Description: "The IPv4 Address of the device which created the API key",
 			},
 			"default_project_id": projectIDSchema(),
+			"rotate_when_expired": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Replace the api key when it has expired",
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will trigger the replacement of the api key",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
+		CustomizeDiff: customizeDiffIamAPIKeyRotation,
+	}
+}
+
+// customizeDiffIamAPIKeyRotation forces the replacement of an expired api key when rotate_when_expired is set.
+// The expiration date stored in the state is compared to the current time, so the key is rotated on the next apply
+// once it is expired even if the configuration did not change.
+func customizeDiffIamAPIKeyRotation(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.Get("rotate_when_expired").(bool) {
+		return nil
+	}
+
+	oldExpiresAt, _ := diff.GetChange("expires_at")
+	expiresAt := expandTimePtr(oldExpiresAt)
+	if expiresAt == nil || time.Now().Before(*expiresAt) {
+		return nil
 	}
+
+	err := diff.SetNewComputed("access_key")
+	if err != nil {
+		return err
+	}
+
+	return diff.ForceNew("access_key")
 }
 
 func resourceScalewayIamAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -93,7 +132,7 @@ func resourceScalewayIamAPIKeyCreate(ctx context.Context, d *schema.ResourceData
 		Description:      d.Get("description").(string),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("secret_key", res.SecretKey)
@@ -113,7 +152,7 @@ func resourceScalewayIamAPIKeyRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("description", res.Description)
 	_ = d.Set("created_at", flattenTime(res.CreatedAt))
@@ -157,7 +196,7 @@ func resourceScalewayIamAPIKeyUpdate(ctx context.Context, d *schema.ResourceData
 	if hasChanged {
 		_, err := api.UpdateAPIKey(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -171,7 +210,7 @@ func resourceScalewayIamAPIKeyDelete(ctx context.Context, d *schema.ResourceData
 		AccessKey: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
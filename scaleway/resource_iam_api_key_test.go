@@ -1,13 +1,18 @@
 package scaleway
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -295,3 +300,46 @@ func testAccCheckScalewayIamAPIKeyDestroy(tt *TestTools) resource.TestCheckFunc
 		return nil
 	}
 }
+
+func TestCustomizeDiffIamAPIKeyRotation(t *testing.T) {
+	// unknownValue is the value used by the SDK for unknown attributes in raw configurations
+	const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+	past := time.Now().Add(-time.Hour).Format(time.RFC3339)
+	future := time.Now().Add(time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name             string
+		stateExpiresAt   string
+		configExpiresAt  string
+		rotate           bool
+		expectedRotation bool
+	}{
+		{name: "not expired", stateExpiresAt: future, configExpiresAt: future, rotate: true},
+		{name: "expired without rotation", stateExpiresAt: past, configExpiresAt: past},
+		{name: "expired with an unchanged configuration", stateExpiresAt: past, configExpiresAt: past, rotate: true, expectedRotation: true},
+		{name: "expired with an unknown date", stateExpiresAt: past, configExpiresAt: unknownValue, rotate: true, expectedRotation: true},
+		{name: "expired with a date in the future", stateExpiresAt: past, configExpiresAt: future, rotate: true, expectedRotation: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "SCWXXXXXXXXXXXXXXXXX",
+				Attributes: map[string]string{
+					"id":                  "SCWXXXXXXXXXXXXXXXXX",
+					"access_key":          "SCWXXXXXXXXXXXXXXXXX",
+					"expires_at":          tt.stateExpiresAt,
+					"rotate_when_expired": strconv.FormatBool(tt.rotate),
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"expires_at":          tt.configExpiresAt,
+				"rotate_when_expired": tt.rotate,
+			})
+
+			diff, err := resourceScalewayIamAPIKey().Diff(context.Background(), state, config, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRotation, diff != nil && diff.RequiresNew())
+		})
+	}
+}
@@ -59,7 +59,7 @@ func resourceScalewayIamApplicationCreate(ctx context.Context, d *schema.Resourc
 		OrganizationID: d.Get("organization_id").(string),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(app.ID)
@@ -77,7 +77,7 @@ func resourceScalewayIamApplicationRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("name", app.Name)
 	_ = d.Set("description", app.Description)
@@ -110,7 +110,7 @@ func resourceScalewayIamApplicationUpdate(ctx context.Context, d *schema.Resourc
 	if hasChanged {
 		_, err := api.UpdateApplication(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -124,7 +124,7 @@ func resourceScalewayIamApplicationDelete(ctx context.Context, d *schema.Resourc
 		ApplicationID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
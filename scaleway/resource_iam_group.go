@@ -79,7 +79,7 @@ func resourceScalewayIamGroupCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	group, err := api.CreateGroup(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(group.ID)
@@ -93,7 +93,7 @@ func resourceScalewayIamGroupCreate(ctx context.Context, d *schema.ResourceData,
 			UserIDs:        userIds,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -110,7 +110,7 @@ func resourceScalewayIamGroupRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", group.Name)
@@ -134,7 +134,7 @@ func resourceScalewayIamGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		GroupID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChanges("name", "description") {
@@ -144,7 +144,7 @@ func resourceScalewayIamGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			Description: expandUpdatedStringPtr(d.Get("description")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -158,7 +158,7 @@ func resourceScalewayIamGroupUpdate(ctx context.Context, d *schema.ResourceData,
 				GroupID:        group.ID,
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		} else {
 			for i := range group.ApplicationIDs {
@@ -167,7 +167,7 @@ func resourceScalewayIamGroupUpdate(ctx context.Context, d *schema.ResourceData,
 					ApplicationID: &group.ApplicationIDs[i],
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 			}
 			for i := range group.UserIDs {
@@ -176,7 +176,7 @@ func resourceScalewayIamGroupUpdate(ctx context.Context, d *schema.ResourceData,
 					UserID:  &group.UserIDs[i],
 				}, scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 			}
 		}
@@ -192,7 +192,7 @@ func resourceScalewayIamGroupDelete(ctx context.Context, d *schema.ResourceData,
 		GroupID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -27,19 +27,22 @@ func resourceScalewayIamGroupMembership() *schema.Resource {
 				Description:  "The ID of the user",
 				ExactlyOneOf: []string{"application_id"},
 				ForceNew:     true,
+				ValidateFunc: validationUUID(),
 			},
 			"application_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Description:  "The ID of the user",
+				Description:  "The ID of the application",
 				ExactlyOneOf: []string{"user_id"},
 				ForceNew:     true,
+				ValidateFunc: validationUUID(),
 			},
 			"group_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The ID of the group to add the user to",
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the group to add the user to",
+				ForceNew:     true,
+				ValidateFunc: validationUUID(),
 			},
 		},
 	}
@@ -57,7 +60,7 @@ func resourceScalewayIamGroupMembershipCreate(ctx context.Context, d *schema.Res
 		ApplicationID: applicationID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(groupMembershipID(group.ID, userID, applicationID))
@@ -69,7 +72,7 @@ func resourceScalewayIamGroupMembershipRead(ctx context.Context, d *schema.Resou
 	api := iamAPI(meta)
 	groupID, userID, applicationID, err := expandGroupMembershipID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	group, err := api.GetGroup(&iam.GetGroupRequest{
@@ -82,7 +85,7 @@ func resourceScalewayIamGroupMembershipRead(ctx context.Context, d *schema.Resou
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	foundInGroup := false
@@ -119,7 +122,7 @@ func resourceScalewayIamGroupMembershipDelete(ctx context.Context, d *schema.Res
 	api := iamAPI(meta)
 	groupID, userID, applicationID, err := expandGroupMembershipID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &iam.RemoveGroupMemberRequest{
@@ -139,7 +142,7 @@ func resourceScalewayIamGroupMembershipDelete(ctx context.Context, d *schema.Res
 
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -166,6 +169,8 @@ func expandGroupMembershipID(id string) (groupID string, userID string, applicat
 		userID = elems[2]
 	case "app":
 		applicationID = elems[2]
+	default:
+		return "", "", "", fmt.Errorf("invalid group member type, expected user or app, got: %s", elems[1])
 	}
 
 	return
@@ -114,10 +114,12 @@ func resourceScalewayIamPolicy() *schema.Resource {
 func resourceScalewayIamPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := iamAPI(meta)
 
+	rules := expandPolicyRuleSpecs(d.Get("rule"))
+
 	pol, err := api.CreatePolicy(&iam.CreatePolicyRequest{
 		Name:           expandOrGenerateString(d.Get("name"), "policy"),
 		Description:    d.Get("description").(string),
-		Rules:          expandPolicyRuleSpecs(d.Get("rule")),
+		Rules:          rules,
 		UserID:         expandStringPtr(d.Get("user_id")),
 		GroupID:        expandStringPtr(d.Get("group_id")),
 		ApplicationID:  expandStringPtr(d.Get("application_id")),
@@ -125,7 +127,7 @@ func resourceScalewayIamPolicyCreate(ctx context.Context, d *schema.ResourceData
 		OrganizationID: d.Get("organization_id").(string),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return policyRulesError(ctx, api, d.Get("organization_id").(string), rules, err)
 	}
 
 	d.SetId(pol.ID)
@@ -143,7 +145,7 @@ func resourceScalewayIamPolicyRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("name", pol.Name)
 	_ = d.Set("description", pol.Description)
@@ -166,9 +168,9 @@ func resourceScalewayIamPolicyRead(ctx context.Context, d *schema.ResourceData,
 
 	listRules, err := api.ListRules(&iam.ListRulesRequest{
 		PolicyID: &pol.ID,
-	})
+	}, scw.WithAllPages())
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to list policy's rules: %w", err))
+		return diagFromErr(fmt.Errorf("failed to list policy's rules: %w", err))
 	}
 
 	_ = d.Set("rule", flattenPolicyRules(listRules.Rules))
@@ -212,17 +214,18 @@ func resourceScalewayIamPolicyUpdate(ctx context.Context, d *schema.ResourceData
 	if hasUpdated {
 		_, err := api.UpdatePolicy(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("rule") {
+		rules := expandPolicyRuleSpecs(d.Get("rule"))
 		_, err := api.SetRules(&iam.SetRulesRequest{
 			PolicyID: d.Id(),
-			Rules:    expandPolicyRuleSpecs(d.Get("rule")),
-		})
+			Rules:    rules,
+		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return policyRulesError(ctx, api, d.Get("organization_id").(string), rules, err)
 		}
 	}
 
@@ -240,7 +243,7 @@ func resourceScalewayIamPolicyDelete(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -58,7 +58,7 @@ func resourceScalewayIamSSKKey() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
-				Description: "The SSH key status",
+				Description: "Whether the SSH key is disabled",
 			},
 		},
 	}
@@ -73,21 +73,21 @@ func resourceScalewayIamSSKKeyCreate(ctx context.Context, d *schema.ResourceData
 		ProjectID: (d.Get("project_id")).(string),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
+	d.SetId(res.ID)
+
 	if _, disabledExists := d.GetOk("disabled"); disabledExists {
 		_, err = iamAPI.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
-			SSHKeyID: d.Id(),
+			SSHKeyID: res.ID,
 			Disabled: expandBoolPtr(getBool(d, "disabled")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
-	d.SetId(res.ID)
-
 	return resourceScalewayIamSSHKeyRead(ctx, d, meta)
 }
 
@@ -102,7 +102,7 @@ func resourceScalewayIamSSHKeyRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -132,29 +132,14 @@ func resourceScalewayIamSSKKeyUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if d.HasChange("disabled") {
-		if _, disabledExists := d.GetOk("disabled"); !disabledExists {
-			_, err := iamAPI.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
-				SSHKeyID: d.Id(),
-				Disabled: expandBoolPtr(false),
-			})
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		} else {
-			_, err := iamAPI.UpdateSSHKey(&iam.UpdateSSHKeyRequest{
-				SSHKeyID: d.Id(),
-				Disabled: expandBoolPtr(getBool(d, "disabled")),
-			})
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
+		req.Disabled = scw.BoolPtr(d.Get("disabled").(bool))
+		hasUpdated = true
 	}
 
 	if hasUpdated {
 		_, err := iamAPI.UpdateSSHKey(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -168,7 +153,7 @@ func resourceScalewayIamSSKKeyDelete(ctx context.Context, d *schema.ResourceData
 		SSHKeyID: d.Id(),
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
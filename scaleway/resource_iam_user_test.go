@@ -0,0 +1,70 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	iam "github.com/scaleway/scaleway-sdk-go/api/iam/v1alpha1"
+)
+
+func TestAccScalewayIamUser_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayIamUserDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_iam_user" "main" {
+					  email = "tf-test-iam-user@scaleway.com"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayIamUserExists(tt, "scaleway_iam_user.main"),
+					resource.TestCheckResourceAttr("scaleway_iam_user.main", "email", "tf-test-iam-user@scaleway.com"),
+					resource.TestCheckResourceAttr("scaleway_iam_user.main", "type", "guest"),
+					resource.TestCheckResourceAttrSet("scaleway_iam_user.main", "organization_id"),
+					resource.TestCheckResourceAttrSet("scaleway_iam_user.main", "status"),
+					resource.TestCheckResourceAttrSet("scaleway_iam_user.main", "created_at"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_iam_user.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalewayIamUserDestroy(tt *TestTools) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "scaleway_iam_user" {
+				continue
+			}
+
+			iamAPI := iamAPI(tt.Meta)
+
+			_, err := iamAPI.GetUser(&iam.GetUserRequest{
+				UserID: rs.Primary.ID,
+			})
+
+			// If no error resource still exist
+			if err == nil {
+				return fmt.Errorf("resource %s(%s) still exist", rs.Type, rs.Primary.ID)
+			}
+
+			// Unexpected api error we return it
+			if !is404Error(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -61,6 +61,11 @@ func resourceScalewayInstanceImage() *schema.Resource {
 				},
 				Description: "The IDs of the additional volumes attached to the image",
 			},
+			"async": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Do not wait for the image to be ready after creation",
+			},
 			"tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -175,7 +180,7 @@ func resourceScalewayInstanceImage() *schema.Resource {
 func resourceScalewayInstanceImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &instance.CreateImageRequest{
@@ -191,7 +196,7 @@ func resourceScalewayInstanceImageCreate(ctx context.Context, d *schema.Resource
 	if volumesExist {
 		snapResponses, err := getSnapshotsFromIds(ctx, extraVolumesIds.([]interface{}), instanceAPI)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		req.ExtraVolumes = expandInstanceImageExtraVolumesTemplates(snapResponses)
 	}
@@ -205,19 +210,21 @@ func resourceScalewayInstanceImageCreate(ctx context.Context, d *schema.Resource
 
 	res, err := instanceAPI.CreateImage(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, res.Image.ID))
 
-	_, err = instanceAPI.WaitForImage(&instance.WaitForImageRequest{
-		ImageID:       res.Image.ID,
-		Zone:          zone,
-		RetryInterval: DefaultWaitRetryInterval,
-		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
-	}, scw.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
+	if !d.Get("async").(bool) {
+		_, err = instanceAPI.WaitForImage(&instance.WaitForImageRequest{
+			ImageID:       res.Image.ID,
+			Zone:          zone,
+			RetryInterval: DefaultWaitRetryInterval,
+			Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	return resourceScalewayInstanceImageRead(ctx, d, meta)
@@ -226,7 +233,7 @@ func resourceScalewayInstanceImageCreate(ctx context.Context, d *schema.Resource
 func resourceScalewayInstanceImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	image, err := instanceAPI.GetImage(&instance.GetImageRequest{
@@ -238,7 +245,7 @@ func resourceScalewayInstanceImageRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", image.Image.Name)
@@ -261,7 +268,7 @@ func resourceScalewayInstanceImageRead(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayInstanceImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &instance.UpdateImageRequest{
@@ -285,13 +292,13 @@ func resourceScalewayInstanceImageUpdate(ctx context.Context, d *schema.Resource
 		ImageID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("additional_volume_ids") {
 		snapResponses, err := getSnapshotsFromIds(ctx, d.Get("additional_volume_ids").([]interface{}), instanceAPI)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		req.ExtraVolumes = expandInstanceImageExtraVolumesTemplates(snapResponses)
 	} else {
@@ -325,17 +332,17 @@ func resourceScalewayInstanceImageUpdate(ctx context.Context, d *schema.Resource
 
 	_, err = waitForInstanceImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = instanceAPI.UpdateImage(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't update image: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't update image: %s", err))
 	}
 
 	_, err = waitForInstanceImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayInstanceImageRead(ctx, d, meta)
@@ -344,12 +351,12 @@ func resourceScalewayInstanceImageUpdate(ctx context.Context, d *schema.Resource
 func resourceScalewayInstanceImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForInstanceImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = instanceAPI.DeleteImage(&instance.DeleteImageRequest{
@@ -358,14 +365,14 @@ func resourceScalewayInstanceImageDelete(ctx context.Context, d *schema.Resource
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	_, err = waitForInstanceImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -46,29 +46,31 @@ func resourceScalewayInstanceIP() *schema.Resource {
 				Optional:    true,
 				Description: "The tags associated with the ip",
 			},
+			"tags_all":        tagsAllSchema(),
 			"zone":            zoneSchema(),
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
 		},
+		CustomizeDiff: customizeDiffDefaultTags,
 	}
 }
 
 func resourceScalewayInstanceIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	iprequest := &instance.CreateIPRequest{
 		Zone:    zone,
 		Project: expandStringPtr(d.Get("project_id")),
 	}
-	tags := expandStrings(d.Get("tags"))
+	tags := expandResourceTags(d, meta)
 	if len(tags) > 0 {
 		iprequest.Tags = tags
 	}
 	res, err := instanceAPI.CreateIP(iprequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	reverseRaw, ok := d.GetOk("reverse")
@@ -81,7 +83,7 @@ func resourceScalewayInstanceIPCreate(ctx context.Context, d *schema.ResourceDat
 		}
 		_, err = instanceAPI.UpdateIP(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -92,20 +94,31 @@ func resourceScalewayInstanceIPCreate(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayInstanceIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	req := &instance.UpdateIPRequest{
 		IP:   ID,
 		Zone: zone,
 	}
 
-	if d.HasChange("tags") {
-		req.Tags = expandUpdatedStringsPtr(d.Get("tags"))
+	if d.HasChanges("tags", "tags_all") {
+		var remoteTags []string
+		if hasIgnoredTags(meta) {
+			res, err := instanceAPI.GetIP(&instance.GetIPRequest{
+				IP:   ID,
+				Zone: zone,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return diagFromErr(err)
+			}
+			remoteTags = res.IP.Tags
+		}
+		req.Tags = expandUpdatedInstanceTags(d, meta, remoteTags)
 	}
 
 	_, err = instanceAPI.UpdateIP(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayInstanceIPRead(ctx, d, meta)
@@ -114,7 +127,7 @@ func resourceScalewayInstanceIPUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayInstanceIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := instanceAPI.GetIP(&instance.GetIPRequest{
@@ -127,7 +140,7 @@ func resourceScalewayInstanceIPRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("address", res.IP.Address.String())
@@ -135,9 +148,10 @@ func resourceScalewayInstanceIPRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("organization_id", res.IP.Organization)
 	_ = d.Set("project_id", res.IP.Project)
 	_ = d.Set("reverse", res.IP.Reverse)
-	if len(res.IP.Tags) > 0 {
-		_ = d.Set("tags", flattenSliceString(res.IP.Tags))
+	if tags := flattenInstanceTags(d, meta, res.IP.Tags); len(tags) > 0 {
+		_ = d.Set("tags", flattenSliceString(tags))
 	}
+	_ = d.Set("tags_all", flattenInstanceTagsAll(meta, res.IP.Tags))
 
 	if res.IP.Server != nil {
 		_ = d.Set("server_id", newZonedIDString(res.IP.Zone, res.IP.Server.ID))
@@ -151,7 +165,7 @@ func resourceScalewayInstanceIPRead(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayInstanceIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = instanceAPI.DeleteIP(&instance.DeleteIPRequest{
@@ -165,7 +179,7 @@ func resourceScalewayInstanceIPDelete(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -50,6 +51,12 @@ func resourceScalewayInstancePlacementGroup() *schema.Resource {
 					instance.PlacementGroupPolicyModeEnforced.String(),
 				}, false),
 			},
+			"stop_servers_on_policy_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Stop the running servers of the placement group while its policy is changed, then start them again",
+			},
 			"policy_respected": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -73,7 +80,7 @@ func resourceScalewayInstancePlacementGroup() *schema.Resource {
 func resourceScalewayInstancePlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := instanceAPI.CreatePlacementGroup(&instance.CreatePlacementGroupRequest{
@@ -85,7 +92,7 @@ func resourceScalewayInstancePlacementGroupCreate(ctx context.Context, d *schema
 		Tags:       expandStrings(d.Get("tags")),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, res.PlacementGroup.ID))
@@ -95,7 +102,7 @@ func resourceScalewayInstancePlacementGroupCreate(ctx context.Context, d *schema
 func resourceScalewayInstancePlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := instanceAPI.GetPlacementGroup(&instance.GetPlacementGroupRequest{
@@ -107,7 +114,7 @@ func resourceScalewayInstancePlacementGroupRead(ctx context.Context, d *schema.R
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", res.PlacementGroup.Name)
@@ -125,7 +132,7 @@ func resourceScalewayInstancePlacementGroupRead(ctx context.Context, d *schema.R
 func resourceScalewayInstancePlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	req := &instance.UpdatePlacementGroupRequest{
 		Zone:             zone,
@@ -158,9 +165,30 @@ func resourceScalewayInstancePlacementGroupUpdate(ctx context.Context, d *schema
 	}
 
 	if hasChanged {
-		_, err = instanceAPI.UpdatePlacementGroup(req, scw.WithContext(ctx))
+		var stoppedServerIDs []string
+		if d.HasChanges("policy_mode", "policy_type") && d.Get("stop_servers_on_policy_change").(bool) {
+			stoppedServerIDs, err = stopInstancePlacementGroupServers(ctx, instanceAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
+		}
+
+		if err == nil {
+			_, err = instanceAPI.UpdatePlacementGroup(req, scw.WithContext(ctx))
+		}
+
+		var diags diag.Diagnostics
 		if err != nil {
-			return diag.FromErr(err)
+			diags = append(diags, diagFromErr(err)...)
+		}
+
+		// Stopped servers are started again even if the update failed so they are not left stopped
+		for _, serverID := range stoppedServerIDs {
+			startErr := reachState(ctx, instanceAPI, zone, serverID, instance.ServerStateRunning, d.Timeout(schema.TimeoutUpdate))
+			if startErr != nil {
+				diags = append(diags, diagFromErr(fmt.Errorf("failed to start server %s: %w", serverID, startErr))...)
+			}
+		}
+
+		if diags.HasError() {
+			return diags
 		}
 	}
 
@@ -170,7 +198,7 @@ func resourceScalewayInstancePlacementGroupUpdate(ctx context.Context, d *schema
 func resourceScalewayInstancePlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = instanceAPI.DeletePlacementGroup(&instance.DeletePlacementGroupRequest{
@@ -179,7 +207,7 @@ func resourceScalewayInstancePlacementGroupDelete(ctx context.Context, d *schema
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
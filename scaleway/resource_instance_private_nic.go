@@ -3,6 +3,7 @@ package scaleway
 import (
 	"context"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
@@ -25,6 +26,14 @@ func resourceScalewayInstancePrivateNIC() *schema.Resource {
 			Delete:  schema.DefaultTimeout(defaultInstancePrivateNICWaitTimeout),
 			Default: schema.DefaultTimeout(defaultInstancePrivateNICWaitTimeout),
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    cty.Object(map[string]cty.Type{"private_network_id": cty.String}),
+				Upgrade: vpcPrivateNetworkIDsUpgradeFunc("private_network_id"),
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"server_id": {
 				Type:        schema.TypeString,
@@ -70,12 +79,12 @@ func resourceScalewayInstancePrivateNIC() *schema.Resource {
 func resourceScalewayInstancePrivateNICCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForInstanceServer(ctx, instanceAPI, zone, expandID(d.Get("server_id")), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	createPrivateNICRequest := &instance.CreatePrivateNICRequest{
@@ -91,12 +100,12 @@ func resourceScalewayInstancePrivateNICCreate(ctx context.Context, d *schema.Res
 		scw.WithContext(ctx),
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForPrivateNIC(ctx, instanceAPI, zone, privateNIC.PrivateNic.ServerID, privateNIC.PrivateNic.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(
@@ -113,11 +122,11 @@ func resourceScalewayInstancePrivateNICCreate(ctx context.Context, d *schema.Res
 func resourceScalewayInstancePrivateNICRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, _, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	zone, privateNICID, serverID, err := parseZonedNestedID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	privateNIC, err := waitForPrivateNIC(ctx, instanceAPI, zone, serverID, privateNICID, d.Timeout(schema.TimeoutRead))
@@ -126,12 +135,12 @@ func resourceScalewayInstancePrivateNICRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	fetchRegion, err := zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("zone", zone)
@@ -149,12 +158,12 @@ func resourceScalewayInstancePrivateNICRead(ctx context.Context, d *schema.Resou
 func resourceScalewayInstancePrivateNICUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, _, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	zone, privateNICID, serverID, err := parseZonedNestedID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("tags") {
@@ -168,7 +177,7 @@ func resourceScalewayInstancePrivateNICUpdate(ctx context.Context, d *schema.Res
 			scw.WithContext(ctx),
 		)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -178,11 +187,11 @@ func resourceScalewayInstancePrivateNICUpdate(ctx context.Context, d *schema.Res
 func resourceScalewayInstancePrivateNICDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, _, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	zone, privateNICID, serverID, err := parseZonedNestedID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForPrivateNIC(ctx, instanceAPI, zone, serverID, privateNICID, d.Timeout(schema.TimeoutDelete))
@@ -190,7 +199,7 @@ func resourceScalewayInstancePrivateNICDelete(ctx context.Context, d *schema.Res
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = instanceAPI.DeletePrivateNIC(&instance.DeletePrivateNICRequest{
@@ -203,7 +212,7 @@ func resourceScalewayInstancePrivateNICDelete(ctx context.Context, d *schema.Res
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForPrivateNIC(ctx, instanceAPI, zone, serverID, privateNICID, d.Timeout(schema.TimeoutDelete))
@@ -211,7 +220,7 @@ func resourceScalewayInstancePrivateNICDelete(ctx context.Context, d *schema.Res
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -56,7 +56,7 @@ func resourceScalewayInstanceSecurityGroupRulesRead(ctx context.Context, d *sche
 
 	instanceAPI, zone, securityGroupID, err := instanceAPIWithZoneAndID(meta, securityGroupZonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("security_group_id", securityGroupZonedID)
@@ -67,7 +67,7 @@ func resourceScalewayInstanceSecurityGroupRulesRead(ctx context.Context, d *sche
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("inbound_rule", inboundRules)
@@ -80,12 +80,12 @@ func resourceScalewayInstanceSecurityGroupRulesUpdate(ctx context.Context, d *sc
 	securityGroupZonedID := d.Id()
 	instanceAPI, zone, securityGroupID, err := instanceAPIWithZoneAndID(meta, securityGroupZonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = updateSecurityGroupeRules(ctx, d, zone, securityGroupID, instanceAPI)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayInstanceSecurityGroupRulesRead(ctx, d, meta)
@@ -95,7 +95,7 @@ func resourceScalewayInstanceSecurityGroupRulesDelete(ctx context.Context, d *sc
 	securityGroupZonedID := d.Id()
 	instanceAPI, zone, securityGroupID, err := instanceAPIWithZoneAndID(meta, securityGroupZonedID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("inbound_rule", nil)
@@ -103,7 +103,7 @@ func resourceScalewayInstanceSecurityGroupRulesDelete(ctx context.Context, d *sc
 
 	err = updateSecurityGroupeRules(ctx, d, zone, securityGroupID, instanceAPI)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -27,7 +28,7 @@ func resourceScalewayInstanceServer() *schema.Resource {
 		UpdateContext: resourceScalewayInstanceServerUpdate,
 		DeleteContext: resourceScalewayInstanceServerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importInstanceServer,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultInstanceServerWaitTimeout),
@@ -36,7 +37,16 @@ func resourceScalewayInstanceServer() *schema.Resource {
 			Delete:  schema.DefaultTimeout(defaultInstanceServerWaitTimeout),
 			Default: schema.DefaultTimeout(defaultInstanceServerWaitTimeout),
 		},
-		SchemaVersion: 0,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type: cty.Object(map[string]cty.Type{
+					"private_network": cty.List(cty.Object(map[string]cty.Type{"pn_id": cty.String})),
+				}),
+				Upgrade: vpcPrivateNetworkIDsUpgradeFunc("private_network.#.pn_id"),
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -47,7 +57,6 @@ func resourceScalewayInstanceServer() *schema.Resource {
 			"image": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				ForceNew:         true,
 				Description:      "The UUID or the label of the base image used by the server",
 				DiffSuppressFunc: diffSuppressFuncLocality,
 				ExactlyOneOf:     []string{"image", "root_volume.0.volume_id"},
@@ -57,6 +66,13 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				Required:         true,
 				Description:      "The instance type of the server", // TODO: link to scaleway pricing in the doc
 				DiffSuppressFunc: diffSuppressFuncIgnoreCase,
+				ValidateDiagFunc: validateStringFormat(commercialTypeFormat, "type"),
+			},
+			"rebuild_on_image_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Rebuild the root volume from the new image instead of re-creating the server if image change",
 			},
 			"replace_on_type_change": {
 				Type:        schema.TypeBool,
@@ -72,6 +88,7 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				Optional:    true,
 				Description: "The tags associated with the server",
 			},
+			"tags_all": tagsAllSchema(),
 			"security_group_id": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -169,6 +186,45 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				Computed:    true,
 				Description: "The public IPv4 address of the server",
 			},
+			"public_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of public IPs attached to the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the IP",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The address of the IP",
+						},
+						"family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address family (inet or inet6)",
+						},
+						"dynamic": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the IP is dynamic",
+						},
+						"gateway": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The gateway of the IP",
+						},
+						"netmask": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR netmask of the IP",
+						},
+					},
+				},
+			},
 			"ip_id": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -225,6 +281,14 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				Description:  "ID of the target bootscript (set boot_type to bootscript)",
 				ValidateFunc: validationUUID(),
 			},
+			"boot_volume_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "ID of an additional volume to boot the server on",
+				ConflictsWith:    []string{"root_volume.0.boot"},
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+			},
 			"cloud_init": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -273,6 +337,30 @@ func resourceScalewayInstanceServer() *schema.Resource {
 					},
 				},
 			},
+			"private_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of private IPv4 and IPv6 addresses attached to the server from IPAM",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the IP address resource",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The private IP address",
+						},
+						"private_network_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the private network the IP belongs to",
+						},
+					},
+				},
+			},
 			"zone":            zoneSchema(),
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
@@ -284,6 +372,8 @@ func resourceScalewayInstanceServer() *schema.Resource {
 				"ip_id",
 			),
 			customDiffInstanceServerType,
+			customDiffInstanceServerImage,
+			customizeDiffDefaultTags,
 		),
 	}
 }
@@ -292,7 +382,7 @@ func resourceScalewayInstanceServer() *schema.Resource {
 func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	////
@@ -307,14 +397,9 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		imageLabel := formatImageLabel(imageUUID)
 
 		marketPlaceAPI := marketplace.NewAPI(meta.(*Meta).scwClient)
-		image, err := marketPlaceAPI.GetLocalImageByLabel(&marketplace.GetLocalImageByLabelRequest{
-			CommercialType: commercialType,
-			Zone:           zone,
-			ImageLabel:     imageLabel,
-			Type:           marketplace.LocalImageTypeInstanceLocal,
-		})
+		image, err := getMarketplaceLocalImageByLabel(ctx, meta, marketPlaceAPI, zone, commercialType, imageLabel)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("could not get image '%s': %s", newZonedID(zone, imageLabel), err))
+			return diagFromErr(fmt.Errorf("could not get image '%s': %s", newZonedID(zone, imageLabel), err))
 		}
 		imageUUID = image.ID
 	}
@@ -327,7 +412,7 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		CommercialType:    commercialType,
 		SecurityGroup:     expandStringPtr(expandZonedID(d.Get("security_group_id")).ID),
 		DynamicIPRequired: scw.BoolPtr(d.Get("enable_dynamic_ip").(bool)),
-		Tags:              expandStrings(d.Get("tags")),
+		Tags:              expandResourceTags(d, meta),
 	}
 
 	enableIPv6, ok := d.GetOk("enable_ipv6")
@@ -352,9 +437,9 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 		req.PlacementGroup = expandStringPtr(expandZonedID(placementGroupID).ID)
 	}
 
-	serverType := getServerType(ctx, instanceAPI, req.Zone, req.CommercialType)
+	serverType := getServerType(ctx, meta, instanceAPI, req.Zone, req.CommercialType)
 	if serverType == nil {
-		return diag.FromErr(fmt.Errorf("could not find a server type associated with %s", req.CommercialType))
+		return diagFromErr(fmt.Errorf("could not find a server type associated with %s", req.CommercialType))
 	}
 
 	req.Volumes = make(map[string]*instance.VolumeServerTemplate)
@@ -403,7 +488,7 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 				VolumeID: expandZonedID(volumeID).ID,
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			req.Volumes[strconv.Itoa(i+1)] = &instance.VolumeServerTemplate{
 				ID:         &vol.Volume.ID,
@@ -413,10 +498,13 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 			}
 		}
 	}
+	if err := setInstanceServerBootVolume(req.Volumes, d.Get("boot_volume_id").(string)); err != nil {
+		return diagFromErr(err)
+	}
 
 	// Validate total local volume sizes.
 	if err = validateLocalVolumeSizes(req.Volumes, serverType, req.CommercialType); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Sanitize the volume map to respect API schemas
@@ -424,14 +512,14 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 
 	res, err := instanceAPI.CreateServer(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedID(zone, res.Server.ID).String())
 
 	_, err = waitForInstanceServer(ctx, instanceAPI, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	////
@@ -457,22 +545,22 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 	if len(userDataRequests.UserData) > 0 {
 		_, err := waitForInstanceServer(ctx, instanceAPI, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = instanceAPI.SetAllServerUserData(userDataRequests)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	targetState, err := serverStateExpand(d.Get("state").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
-	err = reachState(ctx, instanceAPI, zone, res.Server.ID, targetState)
+	err = reachState(ctx, instanceAPI, zone, res.Server.ID, targetState, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	////
@@ -481,33 +569,33 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 	if rawPNICs, ok := d.GetOk("private_network"); ok {
 		vpcAPI, err := vpcAPI(meta)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		pnRequest, err := preparePrivateNIC(ctx, rawPNICs, res.Server, vpcAPI)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		// compute attachment
 		for _, q := range pnRequest {
 			_, err := waitForInstanceServer(ctx, instanceAPI, zone, res.Server.ID, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 
 			pn, err := instanceAPI.CreatePrivateNIC(q, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			tflog.Debug(ctx, fmt.Sprintf("private network created (ID: %s, status: %s)", pn.PrivateNic.ID, pn.PrivateNic.State))
 
 			_, err = waitForPrivateNIC(ctx, instanceAPI, zone, res.Server.ID, pn.PrivateNic.ID, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 
 			_, err = waitForMACAddress(ctx, instanceAPI, zone, res.Server.ID, pn.PrivateNic.ID, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -519,7 +607,7 @@ func resourceScalewayInstanceServerCreate(ctx context.Context, d *schema.Resourc
 func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	server, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutRead))
@@ -529,7 +617,7 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	////
 	// Read Server
@@ -538,7 +626,7 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 	if err == nil {
 		state, err := serverStateFlatten(server.State)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		_ = d.Set("state", state)
 		_ = d.Set("zone", string(zone))
@@ -546,9 +634,10 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 		_ = d.Set("boot_type", server.BootType)
 		_ = d.Set("bootscript_id", server.Bootscript.ID)
 		_ = d.Set("type", server.CommercialType)
-		if len(server.Tags) > 0 {
-			_ = d.Set("tags", server.Tags)
+		if tags := flattenInstanceTags(d, meta, server.Tags); len(tags) > 0 {
+			_ = d.Set("tags", tags)
 		}
+		_ = d.Set("tags_all", flattenInstanceTagsAll(meta, server.Tags))
 		_ = d.Set("security_group_id", newZonedID(zone, server.SecurityGroup.ID).String())
 		_ = d.Set("enable_ipv6", server.EnableIPv6)
 		_ = d.Set("enable_dynamic_ip", server.DynamicIPRequired)
@@ -572,29 +661,28 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 			_ = d.Set("private_ip", flattenStringPtr(server.PrivateIP))
 		}
 
-		if server.PublicIP != nil {
-			_ = d.Set("public_ip", server.PublicIP.Address.String())
-			d.SetConnInfo(map[string]string{
-				"type": "ssh",
-				"host": server.PublicIP.Address.String(),
-			})
-			if !server.PublicIP.Dynamic {
-				_ = d.Set("ip_id", newZonedID(zone, server.PublicIP.ID).String())
+		publicIPv4 := instanceServerPublicIPv4(server)
+		if publicIPv4 != nil {
+			_ = d.Set("public_ip", publicIPv4.Address.String())
+			if !publicIPv4.Dynamic {
+				_ = d.Set("ip_id", newZonedID(zone, publicIPv4.ID).String())
 			} else {
 				_ = d.Set("ip_id", "")
 			}
 		} else {
 			_ = d.Set("public_ip", "")
 			_ = d.Set("ip_id", "")
-			d.SetConnInfo(nil)
 		}
 
-		if server.IPv6 != nil {
-			_ = d.Set("ipv6_address", server.IPv6.Address.String())
-			_ = d.Set("ipv6_gateway", server.IPv6.Gateway.String())
-			prefixLength, err := strconv.Atoi(server.IPv6.Netmask)
+		_ = d.Set("public_ips", flattenInstanceServerPublicIPs(zone, server))
+
+		ipv6 := instanceServerIPv6(server)
+		if ipv6 != nil {
+			_ = d.Set("ipv6_address", ipv6.Address.String())
+			_ = d.Set("ipv6_gateway", ipv6.Gateway.String())
+			prefixLength, err := strconv.Atoi(ipv6.Netmask)
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			_ = d.Set("ipv6_prefix_length", prefixLength)
 		} else {
@@ -603,7 +691,24 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 			_ = d.Set("ipv6_prefix_length", nil)
 		}
 
+		// IPv6 only servers are reached on their IPv6 address
+		switch {
+		case publicIPv4 != nil:
+			d.SetConnInfo(map[string]string{
+				"type": "ssh",
+				"host": publicIPv4.Address.String(),
+			})
+		case ipv6 != nil:
+			d.SetConnInfo(map[string]string{
+				"type": "ssh",
+				"host": ipv6.Address.String(),
+			})
+		default:
+			d.SetConnInfo(nil)
+		}
+
 		var additionalVolumesIDs []string
+		bootVolumeID := ""
 		for i, volume := range sortVolumeServer(server.Volumes) {
 			if i == 0 {
 				rootVolume := map[string]interface{}{}
@@ -623,9 +728,17 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 
 				_ = d.Set("root_volume", []map[string]interface{}{rootVolume})
 			} else {
+				// Scratch volumes provisioned by the API with the server are only tracked when referenced
+				if volume.VolumeType == instance.VolumeServerVolumeType(instanceVolumeTypeScratch) && !isInstanceServerAdditionalVolume(d, volume.ID) {
+					continue
+				}
 				additionalVolumesIDs = append(additionalVolumesIDs, newZonedID(zone, volume.ID).String())
+				if volume.Boot {
+					bootVolumeID = newZonedID(zone, volume.ID).String()
+				}
 			}
 		}
+		_ = d.Set("boot_volume_id", bootVolumeID)
 
 		_ = d.Set("additional_volume_ids", additionalVolumesIDs)
 		if len(additionalVolumesIDs) > 0 {
@@ -643,7 +756,7 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 		for key, value := range allUserData.UserData {
 			userDataValue, err := io.ReadAll(value)
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			// if key != "cloud-init" {
 			userData[key] = string(userDataValue)
@@ -658,15 +771,24 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 		////
 		ph, err := newPrivateNICHandler(instanceAPI, id, zone)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		// set private networks
 		err = ph.set(d)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
+		////
+		// Read server private IPs
+		////
+		privateIPs, err := getInstanceServerPrivateIPs(ctx, meta, zone, server.PrivateNics)
+		if err != nil {
+			return diagFromErr(fmt.Errorf("failed to read server private IPs from IPAM: %w", err))
+		}
+		_ = d.Set("private_ips", privateIPs)
+
 		return nil
 	}
 	return nil
@@ -676,17 +798,19 @@ func resourceScalewayInstanceServerRead(ctx context.Context, d *schema.ResourceD
 func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	wantedState := d.Get("state").(string)
 	isStopped := wantedState == InstanceServerStateStopped
+	// a server in standby is powered off in place, it picks up boot changes when it is started
+	isRunning := wantedState == InstanceServerStateStarted
 
 	var warnings diag.Diagnostics
 
 	server, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	////
 	// Construct UpdateServerRequest
@@ -700,8 +824,8 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 		updateRequest.Name = expandStringPtr(d.Get("name"))
 	}
 
-	if d.HasChange("tags") {
-		updateRequest.Tags = expandUpdatedStringsPtr(d.Get("tags"))
+	if d.HasChanges("tags", "tags_all") {
+		updateRequest.Tags = expandUpdatedInstanceTags(d, meta, server.Tags)
 	}
 
 	if d.HasChange("security_group_id") {
@@ -721,11 +845,21 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 
 	volumes := map[string]*instance.VolumeServerTemplate{}
 
-	if raw, hasAdditionalVolumes := d.GetOk("additional_volume_ids"); d.HasChanges("additional_volume_ids", "root_volume") {
+	// An image change is only planned in place when the root volume is rebuilt, after this update.
+	// The root volume is then unknown until the rebuild and the current one must be kept attached.
+	rebuildRootVolume := d.HasChange("image")
+	rootVolumeID, rootVolumeBoot := d.Get("root_volume.0.volume_id"), d.Get("root_volume.0.boot")
+	if rebuildRootVolume {
+		rootVolumeID, _ = d.GetChange("root_volume.0.volume_id")
+		rootVolumeBoot, _ = d.GetChange("root_volume.0.boot")
+	}
+	volumesHaveChange := d.HasChanges("additional_volume_ids", "boot_volume_id") || (!rebuildRootVolume && d.HasChange("root_volume"))
+
+	if raw, hasAdditionalVolumes := d.GetOk("additional_volume_ids"); volumesHaveChange {
 		volumes["0"] = &instance.VolumeServerTemplate{
-			ID:   scw.StringPtr(expandZonedID(d.Get("root_volume.0.volume_id")).ID),
+			ID:   scw.StringPtr(expandZonedID(rootVolumeID).ID),
 			Name: scw.StringPtr(newRandomName("vol")), // name is ignored by the API, any name will work here
-			Boot: expandBoolPtr(d.Get("root_volume.0.boot")),
+			Boot: expandBoolPtr(rootVolumeBoot),
 		}
 
 		if !hasAdditionalVolumes {
@@ -741,13 +875,14 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 					VolumeID: expandZonedID(volumeID).ID,
 				})
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 
 				// We must be able to tell whether a volume is already present in the server or not
 				if volumeResp.Volume.Server != nil {
-					if volumeResp.Volume.VolumeType == instance.VolumeVolumeTypeLSSD && volumeResp.Volume.Server.ID != "" {
-						return diag.FromErr(fmt.Errorf("instance must be stopped to change local volumes"))
+					isLocalVolume := volumeResp.Volume.VolumeType == instance.VolumeVolumeTypeLSSD || volumeResp.Volume.VolumeType == instanceVolumeTypeScratch
+					if isLocalVolume && volumeResp.Volume.Server.ID != "" {
+						return diagFromErr(fmt.Errorf("instance must be stopped to change local volumes"))
 					}
 				}
 			}
@@ -757,6 +892,19 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 			}
 		}
 
+		// Keep the scratch volumes provisioned by the API, they would be detached otherwise
+		for _, volume := range sortVolumeServer(server.Volumes) {
+			if volume.VolumeType == instance.VolumeServerVolumeType(instanceVolumeTypeScratch) && !isInstanceServerAdditionalVolume(d, volume.ID) {
+				volumes[strconv.Itoa(len(volumes))] = &instance.VolumeServerTemplate{
+					ID:   scw.StringPtr(volume.ID),
+					Name: scw.StringPtr(volume.Name),
+				}
+			}
+		}
+
+		if err := setInstanceServerBootVolume(volumes, d.Get("boot_volume_id").(string)); err != nil {
+			return diagFromErr(err)
+		}
 		updateRequest.Volumes = &volumes
 	}
 
@@ -766,7 +914,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 			updateRequest.PlacementGroup = &instance.NullableStringValue{Null: true}
 		} else {
 			if !isStopped {
-				return diag.FromErr(fmt.Errorf("instance must be stopped to change placement group"))
+				return diagFromErr(fmt.Errorf("instance must be stopped to change placement group"))
 			}
 			updateRequest.PlacementGroup = &instance.NullableStringValue{Value: placementGroupID}
 		}
@@ -778,7 +926,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 	if d.HasChange("ip_id") {
 		server, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		ipID := expandZonedID(d.Get("ip_id")).ID
@@ -790,19 +938,19 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 				Server: &instance.NullableStringValue{Null: true},
 			})
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			// we wait to ensure to not detach the new ip.
 			_, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 		// If a new IP is provided, we attach it to the server
 		if ipID != "" {
 			_, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 
 			_, err = instanceAPI.UpdateIP(&instance.UpdateIPRequest{
@@ -811,12 +959,12 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 				Server: &instance.NullableStringValue{Value: id},
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 
 			_, err = waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -824,7 +972,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 	if d.HasChanges("boot_type") {
 		bootType := instance.BootType(d.Get("boot_type").(string))
 		updateRequest.BootType = &bootType
-		if !isStopped {
+		if isRunning {
 			warnings = append(warnings, diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  "instance may need to be rebooted to use the new boot type",
@@ -834,7 +982,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 
 	if d.HasChanges("bootscript_id") {
 		updateRequest.Bootscript = expandStringPtr(d.Get("bootscript_id").(string))
-		if !isStopped {
+		if isRunning {
 			warnings = append(warnings, diag.Diagnostic{
 				Severity: diag.Warning,
 				Summary:  "instance may need to be rebooted to use the new bootscript",
@@ -857,7 +1005,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 			for key, value := range userDataMap {
 				userDataRequests.UserData[key] = bytes.NewBufferString(value.(string))
 			}
-			if !isStopped && d.HasChange("user_data.cloud-init") {
+			if isRunning && d.HasChange("user_data.cloud-init") {
 				warnings = append(warnings, diag.Diagnostic{
 					Severity: diag.Warning,
 					Summary:  "instance may need to be rebooted to use the new cloud init config",
@@ -867,12 +1015,12 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 
 		_, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		err = instanceAPI.SetAllServerUserData(userDataRequests)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -882,7 +1030,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 	if d.HasChanges("private_network") {
 		ph, err := newPrivateNICHandler(instanceAPI, id, zone)
 		if err != nil {
-			diag.FromErr(err)
+			diagFromErr(err)
 		}
 		if raw, ok := d.GetOk("private_network"); ok {
 			// retrieve all current private network interfaces
@@ -893,16 +1041,16 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 					if !cmp.Equal(n, o) {
 						_, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							return diag.FromErr(err)
+							return diagFromErr(err)
 						}
 
 						err = ph.detach(ctx, o, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							diag.FromErr(err)
+							diagFromErr(err)
 						}
 						err = ph.attach(ctx, n, d.Timeout(schema.TimeoutUpdate))
 						if err != nil {
-							diag.FromErr(err)
+							diagFromErr(err)
 						}
 					}
 				}
@@ -915,12 +1063,12 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 				if pnExist {
 					_, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						return diag.FromErr(err)
+						return diagFromErr(err)
 					}
 
 					err = ph.detach(ctx, pn["pn_id"], d.Timeout(schema.TimeoutUpdate))
 					if err != nil {
-						diag.FromErr(err)
+						diagFromErr(err)
 					}
 				}
 			}
@@ -930,32 +1078,62 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 	// Apply changes
 	////
 
-	if d.HasChange("state") {
+	// A type change or a root volume rebuild stops the server and leaves it in its wanted state,
+	// reaching a started or standby state before them would only cycle the server once more.
+	stateReachedByStop := d.HasChanges("type", "image") && wantedState != InstanceServerStateStopped
+	if d.HasChange("state") && !stateReachedByStop {
 		targetState, err := serverStateExpand(d.Get("state").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		// reach expected state
-		err = reachState(ctx, instanceAPI, zone, id, targetState)
+		err = reachState(ctx, instanceAPI, zone, id, targetState, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	_, err = instanceAPI.UpdateServer(updateRequest)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("type") {
 		err := resourceScalewayInstanceServerMigrate(ctx, d, instanceAPI, zone, id)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("image") {
+		err := resourceScalewayInstanceServerRebuildRootVolume(ctx, d, meta, instanceAPI, zone, id)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
+	// A running server needs a reboot to configure its network with the new IPv6 setting
+	if d.HasChange("enable_ipv6") {
+		server, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diagFromErr(err)
+		}
+		if server.State == instance.ServerStateRunning {
+			err = instanceAPI.ServerActionAndWait(&instance.ServerActionAndWaitRequest{
+				Zone:          zone,
+				ServerID:      id,
+				Action:        instance.ServerActionReboot,
+				Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutUpdate)),
+				RetryInterval: DefaultWaitRetryInterval,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return diagFromErr(err)
+			}
 		}
 	}
 
@@ -965,7 +1143,7 @@ func resourceScalewayInstanceServerUpdate(ctx context.Context, d *schema.Resourc
 func resourceScalewayInstanceServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// detach eip to ensure to free eip even if instance won't stop
 	if ipID, ok := d.GetOk("ip_id"); ok {
@@ -990,17 +1168,17 @@ func resourceScalewayInstanceServerDelete(ctx context.Context, d *schema.Resourc
 		}
 	}
 	// reach stopped state
-	err = reachState(ctx, instanceAPI, zone, id, instance.ServerStateStopped)
+	err = reachState(ctx, instanceAPI, zone, id, instance.ServerStateStopped, d.Timeout(schema.TimeoutDelete))
 	if is404Error(err) {
 		return nil
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = instanceAPI.DeleteServer(&instance.DeleteServerRequest{
@@ -1008,12 +1186,12 @@ func resourceScalewayInstanceServerDelete(ctx context.Context, d *schema.Resourc
 		ServerID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Related to https://github.com/hashicorp/terraform-plugin-sdk/issues/142
@@ -1028,7 +1206,7 @@ func resourceScalewayInstanceServerDelete(ctx context.Context, d *schema.Resourc
 			VolumeID: expandID(volumeID),
 		})
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -1098,13 +1276,17 @@ func customDiffInstanceServerType(_ context.Context, diff *schema.ResourceDiff,
 }
 
 func resourceScalewayInstanceServerMigrate(ctx context.Context, d *schema.ResourceData, instanceAPI *instance.API, zone scw.Zone, id string) error {
-	server, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
+	_, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("failed to wait for server before changing server type: %w", err)
 	}
-	beginningState := server.State
+	// the server is left in its wanted state rather than its beginning one, so it is stopped only once
+	targetState, err := serverStateExpand(d.Get("state").(string))
+	if err != nil {
+		return err
+	}
 
-	err = reachState(ctx, instanceAPI, zone, id, instance.ServerStateStopped)
+	err = reachState(ctx, instanceAPI, zone, id, instance.ServerStateStopped, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("failed to stop server before changing server type: %w", err)
 	}
@@ -1118,10 +1300,130 @@ func resourceScalewayInstanceServerMigrate(ctx context.Context, d *schema.Resour
 		return fmt.Errorf("failed to change server type server")
 	}
 
-	err = reachState(ctx, instanceAPI, zone, id, beginningState)
+	err = reachState(ctx, instanceAPI, zone, id, targetState, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("failed to start server after changing server type: %w", err)
 	}
 
 	return nil
 }
+
+func customDiffInstanceServerImage(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChange("image") || diff.Id() == "" {
+		return nil
+	}
+
+	if !diff.Get("rebuild_on_image_change").(bool) {
+		return diff.ForceNew("image")
+	}
+
+	// The root volume is replaced by a new one, SetNewComputed only accepts top-level keys
+	return diff.SetNewComputed("root_volume")
+}
+
+// resourceScalewayInstanceServerRebuildRootVolume replaces the root volume of the server with a new volume created from its image.
+// The server is stopped during the swap, its ID, IPs, private NICs and additional volumes are kept.
+func resourceScalewayInstanceServerRebuildRootVolume(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceAPI *instance.API, zone scw.Zone, id string) error {
+	server, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("failed to wait for server before rebuilding root volume: %w", err)
+	}
+	// the server is left in its wanted state rather than its beginning one, so it is stopped only once
+	targetState, err := serverStateExpand(d.Get("state").(string))
+	if err != nil {
+		return err
+	}
+
+	// root_volume is unknown while its volume is rebuilt, its settings are kept from the state
+	previousRootVolume, _ := d.GetChange("root_volume")
+	deleteOnTermination, _ := d.GetChange("root_volume.0.delete_on_termination")
+
+	oldRootVolume, hasRootVolume := server.Volumes["0"]
+	if !hasRootVolume {
+		return fmt.Errorf("server has no root volume to rebuild")
+	}
+
+	imageID := expandID(d.Get("image"))
+	if !scwvalidation.IsUUID(imageID) {
+		marketPlaceAPI := marketplace.NewAPI(meta.(*Meta).scwClient)
+		image, err := getMarketplaceLocalImageByLabel(ctx, meta, marketPlaceAPI, zone, server.CommercialType, formatImageLabel(imageID))
+		if err != nil {
+			return fmt.Errorf("could not get image '%s': %s", newZonedID(zone, imageID), err)
+		}
+		imageID = image.ID
+	}
+
+	imageResp, err := instanceAPI.GetImage(&instance.GetImageRequest{
+		Zone:    zone,
+		ImageID: imageID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if imageResp.Image.RootVolume == nil {
+		return fmt.Errorf("image %s has no root volume", imageID)
+	}
+
+	err = reachState(ctx, instanceAPI, zone, id, instance.ServerStateStopped, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("failed to stop server before rebuilding root volume: %w", err)
+	}
+
+	size := oldRootVolume.Size
+	if imageResp.Image.RootVolume.Size > size {
+		size = imageResp.Image.RootVolume.Size
+	}
+	volumeResp, err := instanceAPI.CreateVolume(&instance.CreateVolumeRequest{
+		Zone:         zone,
+		Name:         oldRootVolume.Name,
+		Project:      &server.Project,
+		VolumeType:   instance.VolumeVolumeType(oldRootVolume.VolumeType),
+		Size:         &size,
+		BaseSnapshot: &imageResp.Image.RootVolume.ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to create new root volume: %w", err)
+	}
+
+	_, err = waitForInstanceVolume(ctx, instanceAPI, zone, volumeResp.Volume.ID, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	volumes := map[string]*instance.VolumeServerTemplate{}
+	for key, volume := range server.Volumes {
+		volumes[key] = &instance.VolumeServerTemplate{
+			ID:   scw.StringPtr(volume.ID),
+			Name: scw.StringPtr(newRandomName("vol")), // name is ignored by the API, any name will work here
+			Boot: scw.BoolPtr(volume.Boot),
+		}
+	}
+	volumes["0"].ID = scw.StringPtr(volumeResp.Volume.ID)
+
+	_, err = instanceAPI.UpdateServer(&instance.UpdateServerRequest{
+		Zone:     zone,
+		ServerID: id,
+		Volumes:  &volumes,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to attach new root volume: %w", err)
+	}
+
+	if deleteOnTermination.(bool) {
+		err = instanceAPI.DeleteVolume(&instance.DeleteVolumeRequest{
+			Zone:     zone,
+			VolumeID: oldRootVolume.ID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return fmt.Errorf("failed to delete previous root volume: %w", err)
+		}
+	}
+
+	err = reachState(ctx, instanceAPI, zone, id, targetState, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("failed to restore server state after rebuilding root volume: %w", err)
+	}
+	_ = d.Set("root_volume", previousRootVolume)
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayInstanceServerBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayInstanceServerBackupCreate,
+		ReadContext:   resourceScalewayInstanceServerBackupRead,
+		DeleteContext: resourceScalewayInstanceServerBackupDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Read:    schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Delete:  schema.DefaultTimeout(defaultInstanceImageTimeout),
+			Default: schema.DefaultTimeout(defaultInstanceImageTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+				Description:  "The ID of the server to backup",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the backup image",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that trigger a new backup when they change",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the image created by the backup",
+			},
+			"snapshot_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the snapshots of the server volumes created by the backup",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+		CustomizeDiff: customizeDiffLocalityCheck("server_id"),
+	}
+}
+
+func resourceScalewayInstanceServerBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, serverID, err := instanceAPIWithZoneAndID(meta, d.Get("server_id").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = waitForInstanceServer(ctx, instanceAPI, zone, serverID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	res, err := instanceAPI.ServerAction(&instance.ServerActionRequest{
+		Zone:     zone,
+		ServerID: serverID,
+		Action:   instance.ServerActionBackup,
+		Name:     scw.StringPtr(expandOrGenerateString(d.Get("name"), "backup")),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// The task result is a reference to the created image: "/images/<image-id>"
+	if res.Task == nil || res.Task.HrefResult == "" {
+		return diagFromErr(fmt.Errorf("backup of server %s did not return an image", serverID))
+	}
+	imageID := path.Base(res.Task.HrefResult)
+
+	d.SetId(newZonedIDString(zone, imageID))
+
+	image, err := waitForInstanceImage(ctx, instanceAPI, zone, imageID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	if image.State != instance.ImageStateAvailable {
+		return diagFromErr(fmt.Errorf("backup image %s has state %s, wants %s", imageID, image.State, instance.ImageStateAvailable))
+	}
+
+	return resourceScalewayInstanceServerBackupRead(ctx, d, meta)
+}
+
+func resourceScalewayInstanceServerBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	res, err := instanceAPI.GetImage(&instance.GetImageRequest{
+		Zone:    zone,
+		ImageID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+	image := res.Image
+
+	_ = d.Set("name", image.Name)
+	_ = d.Set("image_id", newZonedIDString(zone, image.ID))
+	_ = d.Set("snapshot_ids", flattenInstanceServerBackupSnapshotIDs(zone, image))
+	_ = d.Set("zone", image.Zone)
+	_ = d.Set("organization_id", image.Organization)
+	_ = d.Set("project_id", image.Project)
+
+	return nil
+}
+
+func resourceScalewayInstanceServerBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	image, err := waitForInstanceImage(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if is404Error(err) {
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	err = instanceAPI.DeleteImage(&instance.DeleteImageRequest{
+		Zone:    zone,
+		ImageID: id,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return diagFromErr(err)
+	}
+
+	// The snapshots of the backup are not deleted with its image
+	for _, zonedSnapshotID := range flattenInstanceServerBackupSnapshotIDs(zone, image) {
+		_, snapshotID, err := parseZonedID(zonedSnapshotID)
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		_, err = waitForInstanceSnapshot(ctx, instanceAPI, zone, snapshotID, d.Timeout(schema.TimeoutDelete))
+		if err != nil && !is404Error(err) {
+			return diagFromErr(err)
+		}
+
+		err = instanceAPI.DeleteSnapshot(&instance.DeleteSnapshotRequest{
+			Zone:       zone,
+			SnapshotID: snapshotID,
+		}, scw.WithContext(ctx))
+		if err != nil && !is404Error(err) {
+			return diagFromErr(err)
+		}
+	}
+
+	return nil
+}
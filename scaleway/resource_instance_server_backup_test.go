@@ -0,0 +1,116 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+)
+
+func TestAccScalewayInstanceServerBackup_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayInstanceServerBackupDestroy(tt),
+			testAccCheckScalewayInstanceServerDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+						state = "stopped"
+					}
+
+					resource "scaleway_instance_server_backup" "main" {
+						server_id = scaleway_instance_server.main.id
+						name      = "tf-test-server-backup"
+						triggers = {
+							version = "1"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayInstanceImageExists(tt, "scaleway_instance_server_backup.main"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_server_backup.main", "server_id", "scaleway_instance_server.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_instance_server_backup.main", "name", "tf-test-server-backup"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_server_backup.main", "image_id", "scaleway_instance_server_backup.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_instance_server_backup.main", "snapshot_ids.#", "1"),
+				),
+			},
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						image = "ubuntu_jammy"
+						type  = "DEV1-S"
+						state = "stopped"
+					}
+
+					resource "scaleway_instance_server_backup" "main" {
+						server_id = scaleway_instance_server.main.id
+						name      = "tf-test-server-backup"
+						triggers = {
+							version = "2"
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayInstanceImageExists(tt, "scaleway_instance_server_backup.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server_backup.main", "triggers.version", "2"),
+					resource.TestCheckResourceAttrSet("scaleway_instance_server_backup.main", "image_id"),
+					resource.TestCheckResourceAttr("scaleway_instance_server_backup.main", "snapshot_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScalewayInstanceServerBackupDestroy(tt *TestTools) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "scaleway_instance_server_backup" {
+				continue
+			}
+			instanceAPI, zone, ID, err := instanceAPIWithZoneAndID(tt.Meta, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			_, err = instanceAPI.GetImage(&instance.GetImageRequest{
+				ImageID: ID,
+				Zone:    zone,
+			})
+			// If no error resource still exist
+			if err == nil {
+				return fmt.Errorf("server backup (%s) still exists", rs.Primary.ID)
+			}
+			// Unexpected api error we return it
+			if !is404Error(err) {
+				return err
+			}
+
+			// The snapshots of the backup must be deleted with it
+			_, snapshotID, err := parseZonedID(rs.Primary.Attributes["snapshot_ids.0"])
+			if err != nil {
+				return err
+			}
+			_, err = instanceAPI.GetSnapshot(&instance.GetSnapshotRequest{
+				SnapshotID: snapshotID,
+				Zone:       zone,
+			})
+			if err == nil {
+				return fmt.Errorf("snapshot (%s) of server backup (%s) still exists", snapshotID, rs.Primary.ID)
+			}
+			if !is404Error(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -1,15 +1,20 @@
 package scaleway
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -807,6 +812,63 @@ func TestAccScalewayInstanceServer_Basic2(t *testing.T) {
 	})
 }
 
+func TestAccScalewayInstanceServer_Import(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayInstanceServerDestroy(tt),
+			testAccCheckScalewayInstanceVolumeDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_vpc_private_network" "main" {
+						name = "tf-tests-instance-server-import"
+					}
+
+					resource "scaleway_instance_volume" "data" {
+						type       = "b_ssd"
+						size_in_gb = 10
+					}
+
+					resource "scaleway_instance_server" "main" {
+						type  = "DEV1-S"
+						image = "ubuntu_focal"
+						state = "stopped"
+						tags  = [ "terraform-test", "scaleway_instance_server", "import" ]
+
+						additional_volume_ids = [ scaleway_instance_volume.data.id ]
+
+						user_data = {
+							foo = "bar"
+						}
+
+						private_network {
+							pn_id = scaleway_vpc_private_network.main.id
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayInstanceServerExists(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "private_network.#", "1"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "additional_volume_ids.#", "1"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "user_data.foo", "bar"),
+				),
+			},
+			{
+				ResourceName:      "scaleway_instance_server.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The image is imported as the ID the label was resolved to
+				ImportStateVerifyIgnore: []string{"image"},
+			},
+		},
+	})
+}
+
 func TestAccScalewayInstanceServer_WithReservedIP(t *testing.T) {
 	tt := NewTestTools(t)
 	defer tt.Cleanup()
@@ -1034,6 +1096,86 @@ func TestAccScalewayInstanceServer_Bootscript(t *testing.T) {
 	})
 }
 
+func TestAccScalewayInstanceServer_BootVolume(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayInstanceVolumeDestroy(tt),
+			testAccCheckScalewayInstanceServerDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_volume" "main" {
+						type       = "b_ssd"
+						size_in_gb = 10
+					}
+
+					resource "scaleway_instance_server" "main" {
+						type  = "DEV1-S"
+						image = "ubuntu_focal"
+						state = "stopped"
+
+						root_volume {
+							boot = true
+						}
+
+						additional_volume_ids = [scaleway_instance_volume.main.id]
+						boot_volume_id        = scaleway_instance_volume.main.id
+					}
+				`,
+				ExpectError: regexp.MustCompile("conflicts with root_volume.0.boot"),
+			},
+			{
+				Config: `
+					resource "scaleway_instance_volume" "main" {
+						type       = "b_ssd"
+						size_in_gb = 10
+					}
+
+					resource "scaleway_instance_server" "main" {
+						type  = "DEV1-S"
+						image = "ubuntu_focal"
+						state = "stopped"
+
+						additional_volume_ids = [scaleway_instance_volume.main.id]
+						boot_volume_id        = scaleway_instance_volume.main.id
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayInstanceServerExists(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttrPair("scaleway_instance_server.main", "boot_volume_id", "scaleway_instance_volume.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "root_volume.0.boot", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "scaleway_instance_volume" "main" {
+						type       = "b_ssd"
+						size_in_gb = 10
+					}
+
+					resource "scaleway_instance_server" "main" {
+						type  = "DEV1-S"
+						image = "ubuntu_focal"
+						state = "stopped"
+
+						additional_volume_ids = [scaleway_instance_volume.main.id]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayInstanceServerExists(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "boot_volume_id", ""),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "additional_volume_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccScalewayInstanceServer_AlterTags(t *testing.T) {
 	tt := NewTestTools(t)
 	defer tt.Cleanup()
@@ -1404,3 +1546,114 @@ func TestAccScalewayInstanceServer_MigrateInvalidLocalVolumeSize(t *testing.T) {
 		},
 	})
 }
+
+func TestAccScalewayInstanceServer_RebuildOnImageChange(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	var serverID, rootVolumeID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayInstanceServerDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						type                    = "DEV1-S"
+						image                   = "ubuntu_focal"
+						rebuild_on_image_change = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayInstanceServerExists(tt, "scaleway_instance_server.main"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["scaleway_instance_server.main"]
+						serverID = rs.Primary.ID
+						rootVolumeID = rs.Primary.Attributes["root_volume.0.volume_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: `
+					resource "scaleway_instance_server" "main" {
+						type                    = "DEV1-S"
+						image                   = "ubuntu_jammy"
+						rebuild_on_image_change = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayInstanceServerExists(tt, "scaleway_instance_server.main"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "image", "ubuntu_jammy"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "state", "started"),
+					resource.TestCheckResourceAttr("scaleway_instance_server.main", "root_volume.0.delete_on_termination", "true"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["scaleway_instance_server.main"]
+						if rs.Primary.ID != serverID {
+							return fmt.Errorf("server was replaced: %s != %s", rs.Primary.ID, serverID)
+						}
+						if rs.Primary.Attributes["root_volume.0.volume_id"] == rootVolumeID {
+							return fmt.Errorf("root volume %s was not rebuilt", rootVolumeID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestCustomDiffInstanceServerImage(t *testing.T) {
+	// Only the image diff is customized, other diff functions need the provider meta
+	r := &schema.Resource{
+		Schema:        resourceScalewayInstanceServer().Schema,
+		CustomizeDiff: customDiffInstanceServerImage,
+	}
+
+	tests := []struct {
+		name                 string
+		image                string
+		rebuild              bool
+		expectedRequiresNew  bool
+		expectedRootComputed bool
+	}{
+		{name: "same image", image: "ubuntu_focal", rebuild: true},
+		{name: "image change", image: "ubuntu_jammy", expectedRequiresNew: true},
+		{name: "image change with rebuild", image: "ubuntu_jammy", rebuild: true, expectedRootComputed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "fr-par-1/11111111-1111-1111-1111-111111111111",
+				Attributes: map[string]string{
+					"id":                                  "fr-par-1/11111111-1111-1111-1111-111111111111",
+					"image":                               "ubuntu_focal",
+					"type":                                "DEV1-S",
+					"rebuild_on_image_change":             strconv.FormatBool(tt.rebuild),
+					"root_volume.#":                       "1",
+					"root_volume.0.volume_id":             "fr-par-1/22222222-2222-2222-2222-222222222222",
+					"root_volume.0.delete_on_termination": "true",
+					"root_volume.0.size_in_gb":            "20",
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"image":                   tt.image,
+				"type":                    "DEV1-S",
+				"rebuild_on_image_change": tt.rebuild,
+			})
+
+			diff, err := r.Diff(context.Background(), state, config, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRequiresNew, diff != nil && diff.RequiresNew())
+			if tt.expectedRequiresNew {
+				return
+			}
+			rootVolume := (*terraform.ResourceAttrDiff)(nil)
+			if diff != nil {
+				rootVolume = diff.Attributes["root_volume.#"]
+			}
+			assert.Equal(t, tt.expectedRootComputed, rootVolume != nil && rootVolume.NewComputed)
+		})
+	}
+}
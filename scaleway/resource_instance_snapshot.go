@@ -60,6 +60,11 @@ func resourceScalewayInstanceSnapshot() *schema.Resource {
 				Computed:    true,
 				Description: "The size of the snapshot in gigabyte",
 			},
+			"async": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Do not wait for the snapshot to be ready after creation",
+			},
 			"tags": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -106,7 +111,7 @@ func resourceScalewayInstanceSnapshot() *schema.Resource {
 func resourceScalewayInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &instance.CreateSnapshotRequest{
@@ -133,19 +138,21 @@ func resourceScalewayInstanceSnapshotCreate(ctx context.Context, d *schema.Resou
 
 	res, err := instanceAPI.CreateSnapshot(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, res.Snapshot.ID))
 
-	_, err = instanceAPI.WaitForSnapshot(&instance.WaitForSnapshotRequest{
-		SnapshotID:    res.Snapshot.ID,
-		Zone:          zone,
-		RetryInterval: DefaultWaitRetryInterval,
-		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	if !d.Get("async").(bool) {
+		_, err = instanceAPI.WaitForSnapshot(&instance.WaitForSnapshotRequest{
+			SnapshotID:    res.Snapshot.ID,
+			Zone:          zone,
+			RetryInterval: DefaultWaitRetryInterval,
+			Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
+		})
+		if err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	return resourceScalewayInstanceSnapshotRead(ctx, d, meta)
@@ -154,7 +161,7 @@ func resourceScalewayInstanceSnapshotCreate(ctx context.Context, d *schema.Resou
 func resourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	snapshot, err := instanceAPI.GetSnapshot(&instance.GetSnapshotRequest{
@@ -166,7 +173,7 @@ func resourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", snapshot.Snapshot.Name)
@@ -180,7 +187,7 @@ func resourceScalewayInstanceSnapshotRead(ctx context.Context, d *schema.Resourc
 func resourceScalewayInstanceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &instance.UpdateSnapshotRequest{
@@ -197,7 +204,7 @@ func resourceScalewayInstanceSnapshotUpdate(ctx context.Context, d *schema.Resou
 
 	_, err = instanceAPI.UpdateSnapshot(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't update snapshot: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't update snapshot: %s", err))
 	}
 
 	return resourceScalewayInstanceSnapshotRead(ctx, d, meta)
@@ -206,12 +213,12 @@ func resourceScalewayInstanceSnapshotUpdate(ctx context.Context, d *schema.Resou
 func resourceScalewayInstanceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForInstanceSnapshot(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = instanceAPI.DeleteSnapshot(&instance.DeleteSnapshotRequest{
@@ -220,14 +227,14 @@ func resourceScalewayInstanceSnapshotDelete(ctx context.Context, d *schema.Resou
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	_, err = waitForInstanceSnapshot(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
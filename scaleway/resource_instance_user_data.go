@@ -54,13 +54,13 @@ func resourceScalewayInstanceUserData() *schema.Resource {
 func resourceScalewayInstanceUserDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	serverID := expandID(d.Get("server_id").(string))
 	server, err := waitForInstanceServer(ctx, instanceAPI, zone, serverID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	key := d.Get("key").(string)
@@ -79,7 +79,7 @@ func resourceScalewayInstanceUserDataCreate(ctx context.Context, d *schema.Resou
 
 	err = instanceAPI.SetServerUserData(userDataRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedNestedIDString(zone, key, server.ID))
@@ -90,12 +90,12 @@ func resourceScalewayInstanceUserDataCreate(ctx context.Context, d *schema.Resou
 func resourceScalewayInstanceUserDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, key, err := instanceAPIWithZoneAndNestedID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	server, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	requestGetUserData := &instance.GetServerUserDataRequest{
@@ -115,12 +115,12 @@ func resourceScalewayInstanceUserDataRead(ctx context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	userDataValue, err := io.ReadAll(serverUserDataRawValue)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("server_id", newZonedID(zone, server.ID).String())
 	_ = d.Set("key", key)
@@ -133,12 +133,12 @@ func resourceScalewayInstanceUserDataRead(ctx context.Context, d *schema.Resourc
 func resourceScalewayInstanceUserDataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, key, err := instanceAPIWithZoneAndNestedID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	server, err := waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	userDataRequest := &instance.SetServerUserDataRequest{
@@ -158,12 +158,12 @@ func resourceScalewayInstanceUserDataUpdate(ctx context.Context, d *schema.Resou
 
 	err = instanceAPI.SetServerUserData(userDataRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForInstanceServer(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayInstanceUserDataRead(ctx, d, meta)
@@ -172,7 +172,7 @@ func resourceScalewayInstanceUserDataUpdate(ctx context.Context, d *schema.Resou
 func resourceScalewayInstanceUserDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, key, err := instanceAPIWithZoneAndNestedID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	deleteUserData := &instance.DeleteServerUserDataRequest{
@@ -188,7 +188,7 @@ func resourceScalewayInstanceUserDataDelete(ctx context.Context, d *schema.Resou
 	err = instanceAPI.DeleteServerUserData(deleteUserData, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
@@ -18,7 +19,7 @@ func resourceScalewayInstanceVolume() *schema.Resource {
 		UpdateContext: resourceScalewayInstanceVolumeUpdate,
 		DeleteContext: resourceScalewayInstanceVolumeDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findInstanceVolumeIDByName),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultInstanceVolumeDeleteTimeout),
@@ -41,6 +42,7 @@ func resourceScalewayInstanceVolume() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					instance.VolumeVolumeTypeBSSD.String(),
 					instance.VolumeVolumeTypeLSSD.String(),
+					instanceVolumeTypeScratch.String(),
 				}, false),
 			},
 			"size_in_gb": {
@@ -78,18 +80,22 @@ func resourceScalewayInstanceVolume() *schema.Resource {
 				Optional:    true,
 				Description: "The tags associated with the volume",
 			},
+			"tags_all":        tagsAllSchema(),
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
 			"zone":            zoneSchema(),
 		},
-		CustomizeDiff: customizeDiffLocalityCheck("from_volume_id", "from_snapshot_id"),
+		CustomizeDiff: customdiff.All(
+			customizeDiffLocalityCheck("from_volume_id", "from_snapshot_id"),
+			customizeDiffDefaultTags,
+		),
 	}
 }
 
 func resourceScalewayInstanceVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, err := instanceAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	createVolumeRequest := &instance.CreateVolumeRequest{
@@ -98,7 +104,7 @@ func resourceScalewayInstanceVolumeCreate(ctx context.Context, d *schema.Resourc
 		VolumeType: instance.VolumeVolumeType(d.Get("type").(string)),
 		Project:    expandStringPtr(d.Get("project_id")),
 	}
-	tags := expandStrings(d.Get("tags"))
+	tags := expandResourceTags(d, meta)
 	if len(tags) > 0 {
 		createVolumeRequest.Tags = tags
 	}
@@ -118,7 +124,7 @@ func resourceScalewayInstanceVolumeCreate(ctx context.Context, d *schema.Resourc
 
 	res, err := instanceAPI.CreateVolume(createVolumeRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't create volume: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't create volume: %s", err))
 	}
 
 	d.SetId(newZonedIDString(zone, res.Volume.ID))
@@ -130,7 +136,7 @@ func resourceScalewayInstanceVolumeCreate(ctx context.Context, d *schema.Resourc
 		Timeout:       scw.TimeDurationPtr(d.Timeout(schema.TimeoutCreate)),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayInstanceVolumeRead(ctx, d, meta)
@@ -139,7 +145,7 @@ func resourceScalewayInstanceVolumeCreate(ctx context.Context, d *schema.Resourc
 func resourceScalewayInstanceVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := instanceAPI.GetVolume(&instance.GetVolumeRequest{
@@ -151,7 +157,7 @@ func resourceScalewayInstanceVolumeRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("couldn't read volume: %v", err))
+		return diagFromErr(fmt.Errorf("couldn't read volume: %v", err))
 	}
 
 	_ = d.Set("name", res.Volume.Name)
@@ -159,7 +165,8 @@ func resourceScalewayInstanceVolumeRead(ctx context.Context, d *schema.ResourceD
 	_ = d.Set("project_id", res.Volume.Project)
 	_ = d.Set("zone", string(zone))
 	_ = d.Set("type", res.Volume.VolumeType.String())
-	_ = d.Set("tags", res.Volume.Tags)
+	_ = d.Set("tags", flattenInstanceTags(d, meta, res.Volume.Tags))
+	_ = d.Set("tags_all", flattenInstanceTagsAll(meta, res.Volume.Tags))
 
 	_, fromVolume := d.GetOk("from_volume_id")
 	_, fromSnapshot := d.GetOk("from_snapshot_id")
@@ -179,7 +186,7 @@ func resourceScalewayInstanceVolumeRead(ctx context.Context, d *schema.ResourceD
 func resourceScalewayInstanceVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &instance.UpdateVolumeRequest{
@@ -193,22 +200,33 @@ func resourceScalewayInstanceVolumeUpdate(ctx context.Context, d *schema.Resourc
 		req.Name = &newName
 	}
 
-	tags := expandStrings(d.Get("tags"))
-	if d.HasChange("tags") && len(tags) > 0 {
-		req.Tags = scw.StringsPtr(expandStrings(d.Get("tags")))
+	tags := expandResourceTags(d, meta)
+	if d.HasChanges("tags", "tags_all") && len(tags) > 0 {
+		req.Tags = scw.StringsPtr(tags)
+	}
+
+	if hasIgnoredTags(meta) {
+		res, err := instanceAPI.GetVolume(&instance.GetVolumeRequest{
+			VolumeID: id,
+			Zone:     zone,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diagFromErr(err)
+		}
+		req.Tags = scw.StringsPtr(appendIgnoredTags(meta.(*Meta).ignoreTags, tags, res.Volume.Tags))
 	}
 
 	if d.HasChange("size_in_gb") {
 		if d.Get("type") != instance.VolumeVolumeTypeBSSD.String() {
-			return diag.FromErr(fmt.Errorf("only block volume can be resized"))
+			return diagFromErr(fmt.Errorf("only block volume can be resized"))
 		}
 		if oldSize, newSize := d.GetChange("size_in_gb"); oldSize.(int) > newSize.(int) {
-			return diag.FromErr(fmt.Errorf("block volumes cannot be resized down"))
+			return diagFromErr(fmt.Errorf("block volumes cannot be resized down"))
 		}
 
 		_, err = waitForInstanceVolume(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		volumeSizeInBytes := scw.Size(uint64(d.Get("size_in_gb").(int)) * gb)
@@ -218,17 +236,17 @@ func resourceScalewayInstanceVolumeUpdate(ctx context.Context, d *schema.Resourc
 			Size:     &volumeSizeInBytes,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("couldn't resize volume: %s", err))
+			return diagFromErr(fmt.Errorf("couldn't resize volume: %s", err))
 		}
 		_, err = waitForInstanceVolume(ctx, instanceAPI, zone, id, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	_, err = instanceAPI.UpdateVolume(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't update volume: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't update volume: %s", err))
 	}
 
 	return resourceScalewayInstanceVolumeRead(ctx, d, meta)
@@ -237,7 +255,7 @@ func resourceScalewayInstanceVolumeUpdate(ctx context.Context, d *schema.Resourc
 func resourceScalewayInstanceVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	instanceAPI, zone, id, err := instanceAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	volume, err := instanceAPI.WaitForVolume(&instance.WaitForVolumeRequest{
@@ -250,11 +268,11 @@ func resourceScalewayInstanceVolumeDelete(ctx context.Context, d *schema.Resourc
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if volume.Server != nil {
-		return diag.FromErr(fmt.Errorf("volume is still attached to a server"))
+		return diagFromErr(fmt.Errorf("volume is still attached to a server"))
 	}
 
 	deleteRequest := &instance.DeleteVolumeRequest{
@@ -264,7 +282,7 @@ func resourceScalewayInstanceVolumeDelete(ctx context.Context, d *schema.Resourc
 
 	err = instanceAPI.DeleteVolume(deleteRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -180,7 +180,7 @@ func resourceScalewayIotDevice() *schema.Resource {
 func resourceScalewayIotDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iotAPI, region, err := iotAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &iot.CreateDeviceRequest{
@@ -238,7 +238,7 @@ func resourceScalewayIotDeviceCreate(ctx context.Context, d *schema.ResourceData
 
 	res, err := iotAPI.CreateDevice(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, res.Device.ID))
@@ -253,7 +253,7 @@ func resourceScalewayIotDeviceCreate(ctx context.Context, d *schema.ResourceData
 			CertificatePem: devCrt.(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	} else {
 		// Update certificate and key as they cannot be retrieved later.
@@ -270,7 +270,7 @@ func resourceScalewayIotDeviceCreate(ctx context.Context, d *schema.ResourceData
 func resourceScalewayIotDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iotAPI, region, deviceID, err := iotAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	device, err := iotAPI.GetDevice(&iot.GetDeviceRequest{
@@ -282,7 +282,7 @@ func resourceScalewayIotDeviceRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", device.Name)
@@ -290,7 +290,7 @@ func resourceScalewayIotDeviceRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("hub_id", newRegionalID(region, device.HubID).String())
 	_ = d.Set("created_at", device.CreatedAt.Format(time.RFC3339))
 	_ = d.Set("updated_at", device.UpdatedAt.Format(time.RFC3339))
-	_ = d.Set("last_activity_at", device.LastActivityAt.Format(time.RFC3339))
+	_ = d.Set("last_activity_at", flattenTime(device.LastActivityAt))
 	_ = d.Set("allow_insecure", device.AllowInsecure)
 	_ = d.Set("allow_multiple_connections", device.AllowMultipleConnections)
 	_ = d.Set("is_connected", device.IsConnected)
@@ -339,7 +339,7 @@ func resourceScalewayIotDeviceRead(ctx context.Context, d *schema.ResourceData,
 			DeviceID: deviceID,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		// Set device certificate.
 		cert := map[string]interface{}{
@@ -355,7 +355,7 @@ func resourceScalewayIotDeviceRead(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayIotDeviceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iotAPI, region, deviceID, err := iotAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	updateRequest := &iot.UpdateDeviceRequest{
@@ -411,7 +411,7 @@ func resourceScalewayIotDeviceUpdate(ctx context.Context, d *schema.ResourceData
 
 	_, err = iotAPI.UpdateDevice(updateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Set the device certificate if changed
@@ -422,7 +422,7 @@ func resourceScalewayIotDeviceUpdate(ctx context.Context, d *schema.ResourceData
 			CertificatePem: d.Get("certificate.0.crt").(string),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -432,7 +432,7 @@ func resourceScalewayIotDeviceUpdate(ctx context.Context, d *schema.ResourceData
 func resourceScalewayIotDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iotAPI, region, deviceID, err := iotAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = iotAPI.DeleteDevice(&iot.DeleteDeviceRequest{
@@ -441,7 +441,7 @@ func resourceScalewayIotDeviceDelete(ctx context.Context, d *schema.ResourceData
 	}, scw.WithContext(ctx))
 	if err != nil {
 		if !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
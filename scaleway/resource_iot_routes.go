@@ -194,13 +194,13 @@ func resourceScalewayIotRoute() *schema.Resource {
 func resourceScalewayIotRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iotAPI, region, err := iotAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	hubID := expandZonedID(d.Get("hub_id")).ID
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &iot.CreateRouteRequest{
@@ -236,19 +236,19 @@ func resourceScalewayIotRouteCreate(ctx context.Context, d *schema.ResourceData,
 			Query:    d.Get(fmt.Sprintf("%s.query", prefixKey)).(string),
 		}
 	} else {
-		return diag.FromErr(fmt.Errorf("no route type have been chosen"))
+		return diagFromErr(fmt.Errorf("no route type have been chosen"))
 	}
 
 	res, err := iotAPI.CreateRoute(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, res.ID))
 
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayIotRouteRead(ctx, d, meta)
@@ -257,7 +257,7 @@ func resourceScalewayIotRouteCreate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayIotRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iotAPI, region, routeID, err := iotAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	response, err := iotAPI.GetRoute(&iot.GetRouteRequest{
@@ -269,7 +269,7 @@ func resourceScalewayIotRouteRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("region", string(region))
@@ -313,13 +313,13 @@ func resourceScalewayIotRouteRead(ctx context.Context, d *schema.ResourceData, m
 func resourceScalewayIotRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	iotAPI, region, routeID, err := iotAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	hubID := expandZonedID(d.Get("hub_id")).ID
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = iotAPI.DeleteRoute(&iot.DeleteRouteRequest{
@@ -330,12 +330,12 @@ func resourceScalewayIotRouteDelete(ctx context.Context, d *schema.ResourceData,
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitIotHub(ctx, iotAPI, region, hubID, d.Timeout(schema.TimeoutCreate))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -22,7 +22,7 @@ func resourceScalewayK8SCluster() *schema.Resource {
 		UpdateContext: resourceScalewayK8SClusterUpdate,
 		DeleteContext: resourceScalewayK8SClusterDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findK8SClusterIDByName),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultK8SClusterTimeout),
@@ -31,7 +31,14 @@ func resourceScalewayK8SCluster() *schema.Resource {
 			Delete:  schema.DefaultTimeout(defaultK8SClusterTimeout),
 			Default: schema.DefaultTimeout(defaultK8SClusterTimeout),
 		},
-		SchemaVersion: 0,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    cty.Object(map[string]cty.Type{"private_network_id": cty.String}),
+				Upgrade: vpcPrivateNetworkIDsUpgradeFunc("private_network_id"),
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -69,6 +76,11 @@ func resourceScalewayK8SCluster() *schema.Resource {
 					k8s.CNIKilo.String(),
 				}, false),
 			},
+			"async": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Do not wait for the cluster to be ready after creation",
+			},
 			"tags": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -282,7 +294,7 @@ func resourceScalewayK8SCluster() *schema.Resource {
 func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	////
@@ -344,10 +356,6 @@ func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceDat
 		autoscalerReq.BalanceSimilarNodeGroups = scw.BoolPtr(balanceSimilarNodeGroups.(bool))
 	}
 
-	if balanceSimilarNodeGroups, ok := d.GetOk("autoscaler_config.0.balance_similar_node_groups"); ok {
-		autoscalerReq.BalanceSimilarNodeGroups = scw.BoolPtr(balanceSimilarNodeGroups.(bool))
-	}
-
 	autoscalerReq.ExpendablePodsPriorityCutoff = scw.Int32Ptr(int32(d.Get("autoscaler_config.0.expendable_pods_priority_cutoff").(int)))
 
 	if utilizationThreshold, ok := d.GetOk("autoscaler_config.0.scale_down_utilization_threshold"); ok {
@@ -405,7 +413,7 @@ func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceDat
 		// if one auto upgrade attribute is set, they all must be set.
 		// if none is set, auto upgrade attributes will be computed.
 		if !(okAutoUpgradeDay && okAutoUpgradeStartHour) {
-			return diag.FromErr(fmt.Errorf("all field or zero field of auto_upgrade must be set"))
+			return diagFromErr(fmt.Errorf("all field or zero field of auto_upgrade must be set"))
 		}
 	}
 
@@ -428,13 +436,13 @@ func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceDat
 	versionIsOnlyMinor := len(strings.Split(version, ".")) == 2
 
 	if versionIsOnlyMinor != clusterAutoUpgradeEnabled {
-		return diag.FromErr(fmt.Errorf("minor version x.y must be used with auto upgrade enabled"))
+		return diagFromErr(fmt.Errorf("minor version x.y must be used with auto upgrade enabled"))
 	}
 
 	if versionIsOnlyMinor {
 		version, err = k8sGetLatestVersionFromMinor(ctx, k8sAPI, region, version)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -450,10 +458,14 @@ func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceDat
 
 	res, err := k8sAPI.CreateCluster(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, res.ID))
+	if d.Get("async").(bool) {
+		return resourceScalewayK8SClusterRead(ctx, d, meta)
+	}
+
 	if clusterType.(string) == "multicloud" {
 		// In case of multi-cloud, we do not have the guarantee that a pool will be created in Scaleway.
 		_, err = waitK8SCluster(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
@@ -462,7 +474,7 @@ func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceDat
 		_, err = waitK8SClusterPool(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayK8SClusterRead(ctx, d, meta)
@@ -471,19 +483,27 @@ func resourceScalewayK8SClusterCreate(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, clusterID, err := k8sAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	////
 	// Read Cluster
 	////
-	cluster, err := waitK8SCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutRead))
+	var cluster *k8s.Cluster
+	if d.Get("async").(bool) {
+		cluster, err = k8sAPI.GetCluster(&k8s.GetClusterRequest{
+			Region:    region,
+			ClusterID: clusterID,
+		}, scw.WithContext(ctx))
+	} else {
+		cluster, err = waitK8SCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutRead))
+	}
 	if err != nil {
 		if is404Error(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("region", string(region))
@@ -509,7 +529,7 @@ func resourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceData,
 	if cluster.AutoUpgrade != nil && cluster.AutoUpgrade.Enabled {
 		version, err = k8sGetMinorVersionFromFull(version)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 	_ = d.Set("version", version)
@@ -537,7 +557,7 @@ func resourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceData,
 				},
 			}
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("kubeconfig", []map[string]interface{}{kubeconfig})
 
@@ -548,7 +568,7 @@ func resourceScalewayK8SClusterRead(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, clusterID, err := k8sAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	canUpgrade := false
@@ -608,13 +628,13 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 	versionIsOnlyMinor := len(strings.Split(version, ".")) == 2
 
 	if versionIsOnlyMinor != autoupgradeEnabled {
-		return diag.FromErr(fmt.Errorf("minor version x.y must be used with auto upgrades enabled"))
+		return diagFromErr(fmt.Errorf("minor version x.y must be used with auto upgrades enabled"))
 	}
 
 	if versionIsOnlyMinor {
 		version, err = k8sGetLatestVersionFromMinor(ctx, k8sAPI, region, version)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -627,7 +647,7 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 			Region:    region,
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		if clusterResp.Version == version {
@@ -729,7 +749,7 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 		if actual == "" {
 			err = migrateToPrivateNetworkCluster(ctx, d, meta)
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 	}
@@ -739,12 +759,12 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 	////
 	_, err = k8sAPI.UpdateCluster(updateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitK8SCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	////
@@ -759,12 +779,12 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 		_, err = k8sAPI.UpgradeCluster(upgradeRequest)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitK8SCluster(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -774,7 +794,7 @@ func resourceScalewayK8SClusterUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayK8SClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, clusterID, err := k8sAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	deleteAdditionalResources := d.Get("delete_additional_resources").(bool)
@@ -791,12 +811,12 @@ func resourceScalewayK8SClusterDelete(ctx context.Context, d *schema.ResourceDat
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = waitK8SClusterDeleted(ctx, k8sAPI, region, clusterID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -812,16 +832,18 @@ func autoscalerConfigSchema() *schema.Resource {
 				Description: "Disable the scale down feature of the autoscaler",
 			},
 			"scale_down_delay_after_add": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "10m",
-				Description: "How long after scale up that scale down evaluation resumes",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "10m",
+				ValidateFunc: validateDuration(),
+				Description:  "How long after scale up that scale down evaluation resumes",
 			},
 			"scale_down_unneeded_time": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "10m",
-				Description: "How long a node should be unneeded before it is eligible for scale down",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "10m",
+				ValidateFunc: validateDuration(),
+				Description:  "How long a node should be unneeded before it is eligible for scale down",
 			},
 			"estimator": {
 				Type:        schema.TypeString,
@@ -864,10 +886,11 @@ func autoscalerConfigSchema() *schema.Resource {
 				Description: "Pods with priority below cutoff will be expendable. They can be killed without any consideration during scale down and they don't cause scale up. Pods with null priority (PodPriority disabled) are non expendable",
 			},
 			"scale_down_utilization_threshold": {
-				Type:        schema.TypeFloat,
-				Optional:    true,
-				Default:     0.5,
-				Description: "Node utilization level, defined as sum of requested resources divided by capacity, below which a node can be considered for scale down",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.5,
+				ValidateFunc: validation.FloatBetween(0, 1),
+				Description:  "Node utilization level, defined as sum of requested resources divided by capacity, below which a node can be considered for scale down",
 			},
 			"max_graceful_termination_sec": {
 				Type:        schema.TypeInt,
@@ -102,6 +102,12 @@ func resourceScalewayK8SPool() *schema.Resource {
 				Default:     true,
 				Description: "Whether to wait for the pool to be ready",
 			},
+			"drain_nodes_before_removal": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to drain the nodes from their workload and wait for their removal before shrinking or deleting the pool",
+			},
 			"placement_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -220,7 +226,7 @@ func resourceScalewayK8SPool() *schema.Resource {
 func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, err := k8sAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	////
@@ -292,7 +298,7 @@ func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData,
 		Region:    region,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	waitForCluster := false
@@ -302,13 +308,13 @@ func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData,
 	} else if cluster.Status == k8s.ClusterStatusCreating {
 		_, err = waitK8SCluster(ctx, k8sAPI, region, cluster.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	res, err := k8sAPI.CreatePool(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, res.ID))
@@ -316,14 +322,14 @@ func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData,
 	if d.Get("wait_for_pool_ready").(bool) { // wait for the pool to be ready if specified (including all its nodes)
 		_, err = waitK8SPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if waitForCluster {
 		_, err = waitK8SCluster(ctx, k8sAPI, region, cluster.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -333,7 +339,7 @@ func resourceScalewayK8SPoolCreate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayK8SPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, poolID, err := k8sAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &k8s.GetPoolRequest{
@@ -350,12 +356,12 @@ func resourceScalewayK8SPoolRead(ctx context.Context, d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	nodes, err := getNodes(ctx, k8sAPI, pool)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("cluster_id", newRegionalIDString(region, pool.ClusterID))
@@ -390,7 +396,7 @@ func resourceScalewayK8SPoolRead(ctx context.Context, d *schema.ResourceData, me
 func resourceScalewayK8SPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, poolID, err := k8sAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	////
@@ -418,6 +424,24 @@ func resourceScalewayK8SPoolUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if !d.Get("autoscaling").(bool) && d.HasChange("size") {
+		oldSize, newSize := d.GetChange("size")
+		if d.Get("drain_nodes_before_removal").(bool) && newSize.(int) < oldSize.(int) {
+			pool, err := waitK8SPoolReady(ctx, k8sAPI, region, poolID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diagFromErr(err)
+			}
+
+			err = drainK8SPoolNodes(ctx, k8sAPI, pool, oldSize.(int)-newSize.(int), d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diagFromErr(err)
+			}
+
+			// The pool cannot be updated while it is scaling down after the node deletions
+			_, err = waitK8SPoolReady(ctx, k8sAPI, region, poolID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return diagFromErr(err)
+			}
+		}
 		updateRequest.Size = scw.Uint32Ptr(uint32(d.Get("size").(int)))
 	}
 
@@ -444,13 +468,13 @@ func resourceScalewayK8SPoolUpdate(ctx context.Context, d *schema.ResourceData,
 
 	res, err := k8sAPI.UpdatePool(updateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.Get("wait_for_pool_ready").(bool) { // wait for the pool to be ready if specified (including all its nodes)
 		_, err = waitK8SPoolReady(ctx, k8sAPI, region, res.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -460,7 +484,25 @@ func resourceScalewayK8SPoolUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayK8SPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	k8sAPI, region, poolID, err := k8sAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	if d.Get("drain_nodes_before_removal").(bool) {
+		pool, err := k8sAPI.GetPool(&k8s.GetPoolRequest{
+			Region: region,
+			PoolID: poolID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) {
+				return nil
+			}
+			return diagFromErr(err)
+		}
+
+		err = drainK8SPoolNodes(ctx, k8sAPI, pool, int(pool.Size), d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	////
@@ -474,7 +516,7 @@ func resourceScalewayK8SPoolDelete(ctx context.Context, d *schema.ResourceData,
 	_, err = k8sAPI.DeletePool(req, scw.WithContext(ctx))
 	if err != nil {
 		if !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -366,6 +366,62 @@ func TestAccScalewayK8SCluster_PoolSize(t *testing.T) {
 	})
 }
 
+func TestAccScalewayK8SCluster_PoolDrainNodes(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	poolConfig := func(size int, tag string) string {
+		return fmt.Sprintf(`
+			data "scaleway_k8s_version" "latest" {
+				name = "latest"
+			}
+
+			resource "scaleway_k8s_cluster" "cluster" {
+				name                        = "cluster-drain-nodes"
+				version                     = data.scaleway_k8s_version.latest.name
+				cni                         = "cilium"
+				delete_additional_resources = true
+			}
+
+			resource "scaleway_k8s_pool" "pool" {
+				cluster_id                 = scaleway_k8s_cluster.cluster.id
+				name                       = "pool"
+				node_type                  = "gp1_xs"
+				size                       = %d
+				autoscaling                = false
+				wait_for_pool_ready        = true
+				drain_nodes_before_removal = true
+				tags                       = [ "terraform-test", "scaleway_k8s_pool", "%s" ]
+			}`, size, tag)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayK8SClusterDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: poolConfig(2, "drain-nodes"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayK8SPoolExists(tt, "scaleway_k8s_pool.pool"),
+					resource.TestCheckResourceAttr("scaleway_k8s_pool.pool", "nodes.#", "2"),
+				),
+			},
+			{
+				// The pool is updated once the drained node is removed
+				Config: poolConfig(1, "drained"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayK8SPoolExists(tt, "scaleway_k8s_pool.pool"),
+					resource.TestCheckResourceAttr("scaleway_k8s_pool.pool", "size", "1"),
+					resource.TestCheckResourceAttr("scaleway_k8s_pool.pool", "status", k8s.PoolStatusReady.String()),
+					resource.TestCheckResourceAttr("scaleway_k8s_pool.pool", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("scaleway_k8s_pool.pool", "tags.2", "drained"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccScalewayK8SCluster_PoolPrivateNetwork(t *testing.T) {
 	tt := NewTestTools(t)
 	defer tt.Cleanup()
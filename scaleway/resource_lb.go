@@ -6,8 +6,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
@@ -25,7 +27,7 @@ func resourceScalewayLb() *schema.Resource {
 		UpdateContext: resourceScalewayLbUpdate,
 		DeleteContext: resourceScalewayLbDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findLbIDByName),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultLbLbTimeout),
@@ -34,11 +36,21 @@ func resourceScalewayLb() *schema.Resource {
 			Delete:  schema.DefaultTimeout(defaultLbLbTimeout),
 			Default: schema.DefaultTimeout(defaultLbLbTimeout),
 		},
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{Version: 0, Type: lbUpgradeV1SchemaType(), Upgrade: lbUpgradeV1SchemaUpgradeFunc},
+			{
+				Version: 1,
+				Type: cty.Object(map[string]cty.Type{
+					"private_network": cty.List(cty.Object(map[string]cty.Type{"private_network_id": cty.String})),
+				}),
+				Upgrade: vpcPrivateNetworkIDsUpgradeFunc("private_network.#.private_network_id"),
+			},
 		},
-		CustomizeDiff: customizeDiffLocalityCheck("ip_id", "private_network.#.private_network_id"),
+		CustomizeDiff: customdiff.All(
+			customizeDiffLocalityCheck("ip_id", "private_network.#.private_network_id"),
+			customizeDiffDefaultTags,
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -55,6 +67,7 @@ func resourceScalewayLb() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: diffSuppressFuncIgnoreCase,
+				ValidateDiagFunc: validateStringFormat(commercialTypeFormat, "type"),
 				Description:      "The type of load-balancer you want to create",
 			},
 			"tags": {
@@ -65,6 +78,7 @@ func resourceScalewayLb() *schema.Resource {
 				},
 				Description: "Array of tags to associate with the load-balancer",
 			},
+			"tags_all": tagsAllSchema(),
 			"ip_id": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -158,7 +172,7 @@ func resourceScalewayLb() *schema.Resource {
 func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	createReq := &lbSDK.ZonedAPICreateLBRequest{
@@ -171,14 +185,10 @@ func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta
 		SslCompatibilityLevel: lbSDK.SSLCompatibilityLevel(*expandStringPtr(d.Get("ssl_compatibility_level"))),
 	}
 
-	if raw, ok := d.GetOk("tags"); ok {
-		for _, tag := range raw.([]interface{}) {
-			createReq.Tags = append(createReq.Tags, tag.(string))
-		}
-	}
+	createReq.Tags = expandResourceTags(d, meta)
 	lb, err := lbAPI.CreateLB(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, lb.ID))
@@ -186,7 +196,7 @@ func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta
 	// check err waiting process
 	_, err = waitForLB(ctx, lbAPI, zone, lb.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// attach private network
@@ -194,17 +204,17 @@ func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta
 	if pnExist {
 		pnConfigs, err := expandPrivateNetworks(pnConfigs)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = attachLBPrivateNetworks(ctx, lbAPI, zone, pnConfigs, lb.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForLB(ctx, lbAPI, zone, lb.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -214,7 +224,7 @@ func resourceScalewayLbCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lb, err := waitForLbInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutRead))
@@ -223,12 +233,12 @@ func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta in
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// set the region from zone
 	region, err := zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("release_ip", false)
@@ -238,7 +248,8 @@ func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta in
 	_ = d.Set("region", region.String())
 	_ = d.Set("organization_id", lb.OrganizationID)
 	_ = d.Set("project_id", lb.ProjectID)
-	_ = d.Set("tags", lb.Tags)
+	_ = d.Set("tags", flattenResourceTags(d, meta, lb.Tags))
+	_ = d.Set("tags_all", lb.Tags)
 	// For now API return lowercase lb type. This should be fixed in a near future on the API side
 	_ = d.Set("type", strings.ToUpper(lb.Type))
 	_ = d.Set("ip_id", newZonedIDString(zone, lb.IP[0].ID))
@@ -251,7 +262,7 @@ func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta in
 		if is404Error(err) {
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("private_network", flattenPrivateNetworkConfigs(privateNetworks))
 	return nil
@@ -261,7 +272,7 @@ func resourceScalewayLbRead(ctx context.Context, d *schema.ResourceData, meta in
 func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &lbSDK.ZonedAPIUpdateLBRequest{
@@ -271,9 +282,9 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	hasChanged := false
 
-	if d.HasChanges("name", "tags") {
+	if d.HasChanges("name", "tags", "tags_all") {
 		req.Name = d.Get("name").(string)
-		req.Tags = expandStrings(d.Get("tags"))
+		req.Tags = expandResourceTags(d, meta)
 		hasChanged = true
 	}
 
@@ -290,12 +301,12 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if hasChanged {
 		_, err = lbAPI.UpdateLB(req, scw.WithContext(ctx))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -309,12 +320,12 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 		lb, err := lbAPI.MigrateLB(migrateReq, scw.WithContext(ctx))
 		if err != nil {
-			diag.FromErr(fmt.Errorf("couldn't migrate load balancer on type: %s. error: %w", lb.Type, err))
+			diagFromErr(fmt.Errorf("couldn't migrate load balancer on type: %s. error: %w", lb.Type, err))
 		}
 
 		_, err = waitForLB(ctx, lbAPI, zone, lb.ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -325,18 +336,18 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 		// check current lb stability state
 		_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		// check that pns are in a stable state
 		pns, err := waitForLBPN(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		pnConfigs, err := expandPrivateNetworks(d.Get("private_network"))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		// select only private networks that have changed
 		pnToDetach := privateNetworksCompare(pnConfigs, pns)
@@ -349,32 +360,32 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 				PrivateNetworkID: pnToDetach[i].PrivateNetworkID,
 			}, scw.WithContext(ctx))
 			if err != nil && !is404Error(err) {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 
 		// check load balancer state
 		_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		// check that pns are in a stable state
 		pns, err = waitForLBPN(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		pnToAttach := privateNetworksCompare(pns, pnConfigs)
 		// attach new/updated private networks
 		_, err = attachLBPrivateNetworks(ctx, lbAPI, zone, pnToAttach, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		privateNetworks, err := waitForLBPN(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		for _, pn := range privateNetworks {
@@ -386,7 +397,7 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 					PrivateNetworkID: pn.PrivateNetworkID,
 				}, scw.WithContext(ctx))
 				if err != nil && !is404Error(err) {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 				return diag.Errorf("attaching private network with id: %s on error state. please check your config", pn.PrivateNetworkID)
 			}
@@ -399,22 +410,22 @@ func resourceScalewayLbUpdate(ctx context.Context, d *schema.ResourceData, meta
 func resourceScalewayLbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// check if current lb is on stable state
 	currentLB, err := waitForLbInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if currentLB.PrivateNetworkCount != 0 {
 		lbPNs, err := lbAPI.ListLBPrivateNetworks(&lbSDK.ZonedAPIListLBPrivateNetworksRequest{
 			Zone: zone,
 			LBID: ID,
-		}, scw.WithContext(ctx))
+		}, scw.WithContext(ctx), scw.WithAllPages())
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		// detach private networks
@@ -425,13 +436,13 @@ func resourceScalewayLbDelete(ctx context.Context, d *schema.ResourceData, meta
 				PrivateNetworkID: pn.PrivateNetworkID,
 			}, scw.WithContext(ctx))
 			if err != nil && !is404Error(err) {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		}
 
 		_, err = waitForLbInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -441,17 +452,17 @@ func resourceScalewayLbDelete(ctx context.Context, d *schema.ResourceData, meta
 		ReleaseIP: false,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLbInstances(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
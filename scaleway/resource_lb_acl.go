@@ -164,12 +164,12 @@ func resourceScalewayLbACL() *schema.Resource {
 func resourceScalewayLbACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, _, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	frontZone, frontID, err := parseZonedID(d.Get("frontend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &lbSDK.ZonedAPICreateACLRequest{
@@ -184,7 +184,7 @@ func resourceScalewayLbACLCreate(ctx context.Context, d *schema.ResourceData, me
 
 	res, err := lbAPI.CreateACL(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(frontZone, res.ID))
@@ -195,7 +195,7 @@ func resourceScalewayLbACLCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceScalewayLbACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	acl, err := lbAPI.GetACL(&lbSDK.ZonedAPIGetACLRequest{
@@ -207,7 +207,7 @@ func resourceScalewayLbACLRead(ctx context.Context, d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("frontend_id", newZonedIDString(zone, acl.Frontend.ID))
@@ -228,7 +228,7 @@ func resourceScalewayLbACLRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceScalewayLbACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &lbSDK.ZonedAPIUpdateACLRequest{
@@ -243,7 +243,7 @@ func resourceScalewayLbACLUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	_, err = lbAPI.UpdateACL(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayLbACLRead(ctx, d, meta)
@@ -252,7 +252,7 @@ func resourceScalewayLbACLUpdate(ctx context.Context, d *schema.ResourceData, me
 func resourceScalewayLbACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = lbAPI.DeleteACL(&lbSDK.ZonedAPIDeleteACLRequest{
@@ -260,7 +260,7 @@ func resourceScalewayLbACLDelete(ctx context.Context, d *schema.ResourceData, me
 		ACLID: ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -32,6 +32,7 @@ func resourceScalewayLbBackend() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{Version: 0, Type: lbUpgradeV1SchemaType(), Upgrade: lbUpgradeV1SchemaUpgradeFunc},
 		},
+		CustomizeDiff: customizeDiffLbBackendServerTags,
 		Schema: map[string]*schema.Schema{
 			"lb_id": {
 				Type:        schema.TypeString,
@@ -95,6 +96,32 @@ func resourceScalewayLbBackend() *schema.Resource {
 				Optional:    true,
 				Description: "Backend server IP addresses list (IPv4 or IPv6)",
 			},
+			"server_tags": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Instance servers with these exact tags are added to the backend servers",
+			},
+			"server_tags_ip_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "private",
+				ValidateFunc: validation.StringInSlice([]string{
+					"private",
+					"public",
+				}, false),
+				Description: "The type of IP of the tagged instance servers added to the backend servers",
+			},
+			"dynamic_server_ips": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed:    true,
+				Description: "Backend server IP addresses resolved from server_tags",
+			},
 			"send_proxy_v2": {
 				Type:        schema.TypeBool,
 				Description: "Enables PROXY protocol version 2",
@@ -327,12 +354,12 @@ E.g. 'failover-website.s3-website.fr-par.scw.cloud' if your bucket website URL i
 func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, _, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// parse lb_id. It will be forced to a zoned lb
 	zone, lbID, err := parseZonedID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	healthCheckPort := d.Get("health_check_port").(int)
@@ -346,29 +373,35 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	healthCheckoutTimeout, err := expandDuration(d.Get("health_check_timeout"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	healthCheckDelay, err := expandDuration(d.Get("health_check_delay"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	timeoutServer, err := expandDuration(d.Get("timeout_server"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	timeoutConnect, err := expandDuration(d.Get("timeout_connect"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	timeoutTunnel, err := expandDuration(d.Get("timeout_tunnel"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+	dynamicServerIPs, err := lbBackendServerIPsFromTags(ctx, meta, zone, expandStrings(d.Get("server_tags")), d.Get("server_tags_ip_type").(string))
+	if err != nil {
+		return diagFromErr(err)
 	}
+	_ = d.Set("dynamic_server_ips", dynamicServerIPs)
+
 	createReq := &lbSDK.ZonedAPICreateBackendRequest{
 		Zone:                     zone,
 		LBID:                     lbID,
@@ -388,7 +421,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 			HTTPSConfig:     expandLbHCHTTPS(d.Get("health_check_https")),
 			CheckSendProxy:  d.Get("health_check_send_proxy").(bool),
 		},
-		ServerIP:              expandStrings(d.Get("server_ips")),
+		ServerIP:              mergeLbBackendServerIPs(expandStrings(d.Get("server_ips")), dynamicServerIPs),
 		ProxyProtocol:         expandLbProxyProtocol(d.Get("proxy_protocol")),
 		TimeoutServer:         timeoutServer,
 		TimeoutConnect:        timeoutConnect,
@@ -405,7 +438,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 	if timeoutQueue, ok := d.GetOk("timeout_queue"); ok {
 		timeout, err := time.ParseDuration(timeoutQueue.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		createReq.TimeoutQueue = &scw.Duration{Seconds: int64(timeout.Seconds())}
 	}
@@ -418,7 +451,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 	if healthCheckTransientDelay, ok := d.GetOk("health_check_transient_delay"); ok {
 		timeout, err := time.ParseDuration(healthCheckTransientDelay.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		createReq.HealthCheck.TransientCheckDelay = &scw.Duration{Seconds: int64(timeout.Seconds()), Nanos: int32(timeout.Nanoseconds())}
 	}
@@ -428,7 +461,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 
 	res, err := lbAPI.CreateBackend(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, res.LB.ID, d.Timeout(schema.TimeoutCreate))
@@ -437,7 +470,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, res.ID))
@@ -448,7 +481,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 func resourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	backend, err := lbAPI.GetBackend(&lbSDK.ZonedAPIGetBackendRequest{
@@ -460,7 +493,7 @@ func resourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("lb_id", newZonedIDString(zone, backend.LB.ID))
@@ -470,7 +503,7 @@ func resourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("forward_port_algorithm", flattenLbForwardPortAlgorithm(backend.ForwardPortAlgorithm))
 	_ = d.Set("sticky_sessions", flattenLbStickySessionsType(backend.StickySessions))
 	_ = d.Set("sticky_sessions_cookie_name", backend.StickySessionsCookieName)
-	_ = d.Set("server_ips", backend.Pool)
+	_ = d.Set("server_ips", lbBackendStaticServerIPs(backend.Pool, expandStrings(d.Get("server_ips")), expandStrings(d.Get("dynamic_server_ips"))))
 	_ = d.Set("proxy_protocol", flattenLbProxyProtocol(backend.ProxyProtocol))
 	_ = d.Set("timeout_server", flattenDuration(backend.TimeoutServer))
 	_ = d.Set("timeout_connect", flattenDuration(backend.TimeoutConnect))
@@ -502,7 +535,7 @@ func resourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -512,12 +545,12 @@ func resourceScalewayLbBackendRead(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, lbID, err := parseZonedID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutUpdate))
@@ -526,20 +559,20 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	timeoutServer, err := expandDuration(d.Get("timeout_server"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	timeoutConnect, err := expandDuration(d.Get("timeout_connect"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	timeoutTunnel, err := expandDuration(d.Get("timeout_tunnel"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &lbSDK.ZonedAPIUpdateBackendRequest{
@@ -567,7 +600,7 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 	if timeoutQueue, ok := d.GetOk("timeout_queue"); ok {
 		timeoutQueueParsed, err := time.ParseDuration(timeoutQueue.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		req.TimeoutQueue = &scw.Duration{Seconds: int64(timeoutQueueParsed.Seconds())}
 	}
@@ -577,16 +610,16 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 
 	_, err = lbAPI.UpdateBackend(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	healthCheckoutTimeout, err := expandDuration(d.Get("health_check_timeout"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	healthCheckDelay, err := expandDuration(d.Get("health_check_delay"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// Update Health Check
 	updateHCRequest := &lbSDK.ZonedAPIUpdateHealthCheckRequest{
@@ -603,7 +636,7 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 	if healthCheckTransientDelay, ok := d.GetOk("health_check_transient_delay"); ok {
 		timeout, err := time.ParseDuration(healthCheckTransientDelay.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		updateHCRequest.TransientCheckDelay = &scw.Duration{Seconds: int64(timeout.Seconds()), Nanos: int32(timeout.Nanoseconds())}
 	}
@@ -615,17 +648,23 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 
 	_, err = lbAPI.UpdateHealthCheck(updateHCRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Update Backend servers
+	dynamicServerIPs, err := lbBackendServerIPsFromTags(ctx, meta, zone, expandStrings(d.Get("server_tags")), d.Get("server_tags_ip_type").(string))
+	if err != nil {
+		return diagFromErr(err)
+	}
+	_ = d.Set("dynamic_server_ips", dynamicServerIPs)
+
 	_, err = lbAPI.SetBackendServers(&lbSDK.ZonedAPISetBackendServersRequest{
 		Zone:      zone,
 		BackendID: ID,
-		ServerIP:  expandStrings(d.Get("server_ips")),
+		ServerIP:  mergeLbBackendServerIPs(expandStrings(d.Get("server_ips")), dynamicServerIPs),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutUpdate))
@@ -634,7 +673,7 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayLbBackendRead(ctx, d, meta)
@@ -643,12 +682,12 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 func resourceScalewayLbBackendDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, lbID, err := parseZonedID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutDelete))
@@ -657,7 +696,7 @@ func resourceScalewayLbBackendDelete(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = lbAPI.DeleteBackend(&lbSDK.ZonedAPIDeleteBackendRequest{
@@ -666,7 +705,7 @@ func resourceScalewayLbBackendDelete(ctx context.Context, d *schema.ResourceData
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutDelete))
@@ -675,7 +714,7 @@ func resourceScalewayLbBackendDelete(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -121,19 +123,32 @@ func resourceScalewayLbCertificate() *schema.Resource {
 				Computed:    true,
 				Description: "The status of certificate",
 			},
+			"status_details": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The details of the status of certificate, such as the reason of an error",
+			},
+			"renew_before_days": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				ConflictsWith: []string{"custom_certificate"},
+				Description:   "Number of days before the expiration of the Let's Encrypt certificate from which a new one is planned",
+			},
 		},
+		CustomizeDiff: resourceScalewayLbCertificateCustomDiff,
 	}
 }
 
 func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	zone, lbID, err := parseZonedID(d.Get("lb_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lbAPI, _, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	createReq := &lbSDK.ZonedAPICreateCertificateRequest{
@@ -144,7 +159,7 @@ func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.Resource
 		CustomCertificate: expandLbCustomCertificate(d.Get("custom_certificate")),
 	}
 	if createReq.Letsencrypt == nil && createReq.CustomCertificate == nil {
-		return diag.FromErr(errors.New("you need to define either letsencrypt or custom_certificate configuration"))
+		return diagFromErr(errors.New("you need to define either letsencrypt or custom_certificate configuration"))
 	}
 
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutCreate))
@@ -153,12 +168,12 @@ func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	certificate, err := lbAPI.CreateCertificate(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, certificate.ID))
@@ -169,7 +184,7 @@ func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.Resource
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayLbCertificateRead(ctx, d, meta)
@@ -178,7 +193,7 @@ func resourceScalewayLbCertificateCreate(ctx context.Context, d *schema.Resource
 func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	certificate, err := lbAPI.GetCertificate(&lbSDK.ZonedAPIGetCertificateRequest{
@@ -190,7 +205,7 @@ func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("lb_id", newZonedIDString(zone, certificate.LB.ID))
@@ -201,6 +216,7 @@ func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("not_valid_before", flattenTime(certificate.NotValidBefore))
 	_ = d.Set("not_valid_after", flattenTime(certificate.NotValidAfter))
 	_ = d.Set("status", certificate.Status)
+	_ = d.Set("status_details", flattenStringPtr(certificate.StatusDetails))
 
 	diags := diag.Diagnostics(nil)
 
@@ -221,7 +237,7 @@ func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayLbCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("name") {
@@ -233,19 +249,19 @@ func resourceScalewayLbCertificateUpdate(ctx context.Context, d *schema.Resource
 
 		_, err = lbAPI.UpdateCertificate(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForLBCertificate(ctx, lbAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		if err != nil {
 			if is403Error(err) {
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -255,12 +271,12 @@ func resourceScalewayLbCertificateUpdate(ctx context.Context, d *schema.Resource
 func resourceScalewayLbCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, id, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLBCertificate(ctx, lbAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = lbAPI.DeleteCertificate(&lbSDK.ZonedAPIDeleteCertificateRequest{
@@ -268,13 +284,37 @@ func resourceScalewayLbCertificateDelete(ctx context.Context, d *schema.Resource
 		CertificateID: id,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForLBCertificate(ctx, lbAPI, zone, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is403Error(err) && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
 }
+
+// resourceScalewayLbCertificateCustomDiff plans the replacement of a Let's Encrypt certificate expiring within renew_before_days.
+// Custom certificates are not replaced as a new one would be created from the same expiring chain.
+func resourceScalewayLbCertificateCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	renewBeforeDays := diff.Get("renew_before_days").(int)
+	notValidAfter := diff.Get("not_valid_after").(string)
+	if diff.Id() == "" || renewBeforeDays == 0 || notValidAfter == "" || len(diff.Get("letsencrypt").([]interface{})) == 0 {
+		return nil
+	}
+
+	expiration, err := time.Parse(time.RFC3339, notValidAfter)
+	if err != nil {
+		return err
+	}
+	if time.Until(expiration) > time.Duration(renewBeforeDays)*24*time.Hour {
+		return nil
+	}
+
+	err = diff.SetNewComputed("not_valid_after")
+	if err != nil {
+		return err
+	}
+	return diff.ForceNew("not_valid_after")
+}
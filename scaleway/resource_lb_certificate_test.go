@@ -1,12 +1,17 @@
 package scaleway
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccScalewayLbCertificate_Basic(t *testing.T) {
@@ -256,3 +261,64 @@ func testAccCheckScalewayLbCertificateDestroy(tt *TestTools) resource.TestCheckF
 		return nil
 	}
 }
+
+func TestResourceScalewayLbCertificateCustomDiff(t *testing.T) {
+	// Only the renewal diff is customized, the resource is not read from the API
+	r := &schema.Resource{
+		Schema:        resourceScalewayLbCertificate().Schema,
+		CustomizeDiff: resourceScalewayLbCertificateCustomDiff,
+	}
+	expiringSoon := time.Now().Add(10 * 24 * time.Hour).Format(time.RFC3339)
+	expiringLater := time.Now().Add(60 * 24 * time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name                string
+		notValidAfter       string
+		letsencrypt         bool
+		expectedRequiresNew bool
+	}{
+		{name: "letsencrypt expiring", notValidAfter: expiringSoon, letsencrypt: true, expectedRequiresNew: true},
+		{name: "letsencrypt not expiring", notValidAfter: expiringLater, letsencrypt: true},
+		{name: "custom certificate expiring", notValidAfter: expiringSoon},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attributes := map[string]string{
+				"id":                "fr-par-1/11111111-1111-1111-1111-111111111111",
+				"lb_id":             "fr-par-1/22222222-2222-2222-2222-222222222222",
+				"name":              "cert",
+				"zone":              "fr-par-1",
+				"renew_before_days": "30",
+				"not_valid_after":   tt.notValidAfter,
+			}
+			raw := map[string]interface{}{
+				"lb_id":             "fr-par-1/22222222-2222-2222-2222-222222222222",
+				"name":              "cert",
+				"zone":              "fr-par-1",
+				"renew_before_days": 30,
+			}
+			if tt.letsencrypt {
+				attributes["letsencrypt.#"] = "1"
+				attributes["letsencrypt.0.common_name"] = "example.com"
+				raw["letsencrypt"] = []interface{}{map[string]interface{}{"common_name": "example.com"}}
+			} else {
+				attributes["custom_certificate.#"] = "1"
+				attributes["custom_certificate.0.certificate_chain"] = "chain"
+				raw["custom_certificate"] = []interface{}{map[string]interface{}{"certificate_chain": "chain"}}
+			}
+			state := &terraform.InstanceState{ID: attributes["id"], Attributes: attributes}
+
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRequiresNew, diff != nil && diff.RequiresNew())
+		})
+	}
+
+	diags := resourceScalewayLbCertificate().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"lb_id":              "fr-par-1/22222222-2222-2222-2222-222222222222",
+		"renew_before_days":  30,
+		"custom_certificate": []interface{}{map[string]interface{}{"certificate_chain": "chain"}},
+	}))
+	assert.True(t, diags.HasError(), "renew_before_days must conflict with custom_certificate")
+}
@@ -58,7 +58,7 @@ func resourceScalewayLbIP() *schema.Resource {
 func resourceScalewayLbIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	zoneAttribute, ok := d.GetOk("zone")
@@ -74,7 +74,7 @@ func resourceScalewayLbIPCreate(ctx context.Context, d *schema.ResourceData, met
 
 	res, err := lbAPI.CreateIP(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, res.ID))
@@ -85,7 +85,7 @@ func resourceScalewayLbIPCreate(ctx context.Context, d *schema.ResourceData, met
 func resourceScalewayLbIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ip, err := lbAPI.GetIP(&lbSDK.ZonedAPIGetIPRequest{
@@ -97,7 +97,7 @@ func resourceScalewayLbIPRead(ctx context.Context, d *schema.ResourceData, meta
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// check lb state if it is attached
@@ -108,14 +108,14 @@ func resourceScalewayLbIPRead(ctx context.Context, d *schema.ResourceData, meta
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	// set the region from zone
 	region, err := zone.Region()
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("region", string(region))
@@ -132,7 +132,7 @@ func resourceScalewayLbIPRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceScalewayLbIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var ip *lbSDK.IP
@@ -157,7 +157,7 @@ func resourceScalewayLbIPUpdate(ctx context.Context, d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if ip.LBID != nil {
@@ -167,7 +167,7 @@ func resourceScalewayLbIPUpdate(ctx context.Context, d *schema.ResourceData, met
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -180,7 +180,7 @@ func resourceScalewayLbIPUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		_, err = lbAPI.UpdateIP(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -191,7 +191,7 @@ func resourceScalewayLbIPUpdate(ctx context.Context, d *schema.ResourceData, met
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -202,7 +202,7 @@ func resourceScalewayLbIPUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceScalewayLbIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var ip *lbSDK.IP
@@ -223,7 +223,7 @@ func resourceScalewayLbIPDelete(ctx context.Context, d *schema.ResourceData, met
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// check lb state
@@ -234,7 +234,7 @@ func resourceScalewayLbIPDelete(ctx context.Context, d *schema.ResourceData, met
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -244,7 +244,7 @@ func resourceScalewayLbIPDelete(ctx context.Context, d *schema.ResourceData, met
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// check lb state
@@ -255,7 +255,7 @@ func resourceScalewayLbIPDelete(ctx context.Context, d *schema.ResourceData, met
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -68,17 +68,17 @@ func resourceScalewayLbRoute() *schema.Resource {
 func resourceScalewayLbRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, err := lbAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	frontZone, frontID, err := parseZonedID(d.Get("frontend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	backZone, backID, err := parseZonedID(d.Get("backend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if frontZone != backZone {
@@ -97,7 +97,7 @@ func resourceScalewayLbRouteCreate(ctx context.Context, d *schema.ResourceData,
 
 	route, err := lbAPI.CreateRoute(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, route.ID))
@@ -108,7 +108,7 @@ func resourceScalewayLbRouteCreate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayLbRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	route, err := lbAPI.GetRoute(&lbSDK.ZonedAPIGetRouteRequest{
@@ -120,7 +120,7 @@ func resourceScalewayLbRouteRead(ctx context.Context, d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("frontend_id", newZonedIDString(zone, route.FrontendID))
@@ -136,12 +136,12 @@ func resourceScalewayLbRouteRead(ctx context.Context, d *schema.ResourceData, me
 func resourceScalewayLbRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	backZone, backID, err := parseZonedID(d.Get("backend_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if zone != backZone {
@@ -160,7 +160,7 @@ func resourceScalewayLbRouteUpdate(ctx context.Context, d *schema.ResourceData,
 
 	_, err = lbAPI.UpdateRoute(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayLbRouteRead(ctx, d, meta)
@@ -169,7 +169,7 @@ func resourceScalewayLbRouteUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayLbRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = lbAPI.DeleteRoute(&lbSDK.ZonedAPIDeleteRouteRequest{
@@ -178,7 +178,7 @@ func resourceScalewayLbRouteDelete(ctx context.Context, d *schema.ResourceData,
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
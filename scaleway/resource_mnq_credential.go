@@ -115,7 +115,7 @@ func resourceScalewayMNQCredential() *schema.Resource {
 func resourceScalewayMNQCredentialCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := newMNQAPI(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &mnq.CreateCredentialRequest{
@@ -133,7 +133,7 @@ func resourceScalewayMNQCredentialCreate(ctx context.Context, d *schema.Resource
 	}
 	credential, err := api.CreateCredential(request, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set(
 		"nats_credentials",
@@ -152,7 +152,7 @@ func resourceScalewayMNQCredentialCreate(ctx context.Context, d *schema.Resource
 func resourceScalewayMNQCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := mnqAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &mnq.GetCredentialRequest{
@@ -166,7 +166,7 @@ func resourceScalewayMNQCredentialRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", credential.Name)
@@ -213,7 +213,7 @@ func setPermissionsSQS(credentials *mnq.CredentialSQSSNSCreds) interface{} {
 func resourceScalewayMNQCredentialUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := mnqAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &mnq.UpdateCredentialRequest{
@@ -235,7 +235,7 @@ func resourceScalewayMNQCredentialUpdate(ctx context.Context, d *schema.Resource
 
 	_, err = api.UpdateCredential(request, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayMNQCredentialRead(ctx, d, meta)
@@ -244,7 +244,7 @@ func resourceScalewayMNQCredentialUpdate(ctx context.Context, d *schema.Resource
 func resourceScalewayMNQCredentialDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := mnqAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &mnq.DeleteCredentialRequest{
@@ -253,7 +253,7 @@ func resourceScalewayMNQCredentialDelete(ctx context.Context, d *schema.Resource
 	}
 	err = api.DeleteCredential(request, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
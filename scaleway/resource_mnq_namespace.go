@@ -69,7 +69,7 @@ func resourceScalewayMNQNamespace() *schema.Resource {
 func resourceScalewayMNQNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := newMNQAPI(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &mnq.CreateNamespaceRequest{
@@ -80,7 +80,7 @@ func resourceScalewayMNQNamespaceCreate(ctx context.Context, d *schema.ResourceD
 	}
 	namespace, err := api.CreateNamespace(request, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalID(namespace.Region, namespace.ID).String())
@@ -91,7 +91,7 @@ func resourceScalewayMNQNamespaceCreate(ctx context.Context, d *schema.ResourceD
 func resourceScalewayMNQNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := mnqAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &mnq.GetNamespaceRequest{
@@ -105,7 +105,7 @@ func resourceScalewayMNQNamespaceRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", namespace.Name)
@@ -122,7 +122,7 @@ func resourceScalewayMNQNamespaceRead(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayMNQNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := mnqAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &mnq.UpdateNamespaceRequest{
@@ -136,7 +136,7 @@ func resourceScalewayMNQNamespaceUpdate(ctx context.Context, d *schema.ResourceD
 
 	_, err = api.UpdateNamespace(request, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayMNQNamespaceRead(ctx, d, meta)
@@ -145,7 +145,7 @@ func resourceScalewayMNQNamespaceUpdate(ctx context.Context, d *schema.ResourceD
 func resourceScalewayMNQNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := mnqAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	request := &mnq.DeleteNamespaceRequest{
@@ -154,7 +154,7 @@ func resourceScalewayMNQNamespaceDelete(ctx context.Context, d *schema.ResourceD
 	}
 	err = api.DeleteNamespace(request, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
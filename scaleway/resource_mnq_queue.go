@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nats-io/nats.go"
 	mnq "github.com/scaleway/scaleway-sdk-go/api/mnq/v1alpha1"
@@ -138,6 +139,18 @@ func resourceScalewayMNQQueueSQS() *schema.Resource {
 				Default:      DefaultQueueVisibilityTimeout,
 				ValidateFunc: validation.IntBetween(0, 43_200),
 			},
+			"redrive_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "The JSON redrive policy of the queue, used to send messages to a dead-letter queue after maxReceiveCount receives",
+			},
+			"arn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ARN of the queue, used as deadLetterTargetArn in a redrive policy",
+			},
 			"url": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -176,12 +189,12 @@ func resourceScalewayMNQQueueNATS() *schema.Resource {
 func resourceScalewayMNQQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := newMNQAPI(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	namespaceRegion, namespaceID, err := parseRegionalID(d.Get("namespace_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if region != namespaceRegion {
 		return diag.Errorf("region of the queue (%s) and the namespace (%s) must match", region, namespaceRegion)
@@ -192,7 +205,7 @@ func resourceScalewayMNQQueueCreate(ctx context.Context, d *schema.ResourceData,
 		NamespaceID: namespaceID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	switch namespace.Protocol {
@@ -208,7 +221,7 @@ func resourceScalewayMNQQueueCreate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayMNQQueueCreateSQS(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := SQSClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	isFifo := d.Get("sqs.0.fifo_queue").(bool)
@@ -216,7 +229,7 @@ func resourceScalewayMNQQueueCreateSQS(ctx context.Context, d *schema.ResourceDa
 
 	attributes, err := sqsResourceDataToAttributes(d, resourceScalewayMNQQueue().Schema)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &sqs.CreateQueueInput{
@@ -237,7 +250,7 @@ func resourceScalewayMNQQueueCreateSQS(ctx context.Context, d *schema.ResourceDa
 
 	namespaceRegion, namespaceID, err := parseRegionalID(d.Get("namespace_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.SetId(composeMNQQueueID(namespaceRegion, namespaceID, *input.QueueName))
 
@@ -247,7 +260,7 @@ func resourceScalewayMNQQueueCreateSQS(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayMNQQueueCreateNATS(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := NATSClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	name := resourceMNQQueueName(d.Get("name"), d.Get("name_prefix"), false, false)
@@ -273,12 +286,12 @@ func resourceScalewayMNQQueueCreateNATS(ctx context.Context, d *schema.ResourceD
 		Retention: retention,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	namespaceRegion, namespaceID, err := parseRegionalID(d.Get("namespace_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	d.SetId(composeMNQQueueID(namespaceRegion, namespaceID, name))
 
@@ -288,7 +301,7 @@ func resourceScalewayMNQQueueCreateNATS(ctx context.Context, d *schema.ResourceD
 func resourceScalewayMNQQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	namespace, err := getMNQNamespaceFromComposedQueueID(ctx, d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	switch namespace.Protocol {
@@ -304,12 +317,12 @@ func resourceScalewayMNQQueueRead(ctx context.Context, d *schema.ResourceData, m
 func resourceScalewayMNQQueueReadSQS(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := SQSClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	namespaceRegion, namespaceID, queueName, err := decomposeMNQQueueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	queue, err := retryWhenAWSErrCodeEquals(ctx, []string{sqs.ErrCodeQueueDoesNotExist}, &RetryWhenConfig[*sqs.GetQueueUrlOutput]{
@@ -343,6 +356,7 @@ func resourceScalewayMNQQueueReadSQS(ctx context.Context, d *schema.ResourceData
 
 	sqs := values["sqs"].([]interface{})[0].(map[string]interface{})
 	sqs["url"] = flattenStringPtr(queue.QueueUrl)
+	sqs["arn"] = flattenStringPtr(queueAttributes.Attributes["QueueArn"])
 	sqs["endpoint"] = d.Get("sqs.0.endpoint").(string)
 	sqs["access_key"] = d.Get("sqs.0.access_key").(string)
 	sqs["secret_key"] = d.Get("sqs.0.secret_key").(string)
@@ -361,17 +375,17 @@ func resourceScalewayMNQQueueReadSQS(ctx context.Context, d *schema.ResourceData
 func resourceScalewayMNQQueueReadNATS(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := NATSClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	namespaceRegion, namespaceID, queueName, err := decomposeMNQQueueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	stream, err := client.StreamInfo(queueName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("namespace_id", newRegionalIDString(namespaceRegion, namespaceID))
@@ -385,7 +399,7 @@ func resourceScalewayMNQQueueReadNATS(_ context.Context, d *schema.ResourceData,
 func resourceScalewayMNQQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	namespace, err := getMNQNamespaceFromComposedQueueID(ctx, d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	switch namespace.Protocol {
@@ -401,12 +415,12 @@ func resourceScalewayMNQQueueUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayMNQQueueUpdateSQS(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := SQSClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, _, queueName, err := decomposeMNQQueueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	queue, err := retryWhenAWSErrCodeEquals(ctx, []string{sqs.ErrCodeQueueDoesNotExist}, &RetryWhenConfig[*sqs.GetQueueUrlOutput]{
@@ -424,7 +438,12 @@ func resourceScalewayMNQQueueUpdateSQS(ctx context.Context, d *schema.ResourceDa
 
 	attributes, err := sqsResourceDataToAttributes(d, resourceScalewayMNQQueue().Schema)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	// An empty redrive policy removes the dead-letter queue
+	if d.HasChange("sqs.0.redrive_policy") && d.Get("sqs.0.redrive_policy").(string) == "" {
+		attributes[sqs.QueueAttributeNameRedrivePolicy] = aws.String("")
 	}
 
 	_, err = client.SetQueueAttributesWithContext(ctx, &sqs.SetQueueAttributesInput{
@@ -441,17 +460,17 @@ func resourceScalewayMNQQueueUpdateSQS(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayMNQQueueUpdateNATS(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := NATSClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, _, queueName, err := decomposeMNQQueueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	stream, err := client.StreamInfo(queueName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	maxAge := d.Get("message_max_age").(int)
@@ -477,7 +496,7 @@ func resourceScalewayMNQQueueUpdateNATS(ctx context.Context, d *schema.ResourceD
 		Retention: retention,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	return resourceScalewayMNQQueueReadNATS(ctx, d, meta)
 }
@@ -485,7 +504,7 @@ func resourceScalewayMNQQueueUpdateNATS(ctx context.Context, d *schema.ResourceD
 func resourceScalewayMNQQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	namespace, err := getMNQNamespaceFromComposedQueueID(ctx, d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	switch namespace.Protocol {
@@ -501,12 +520,12 @@ func resourceScalewayMNQQueueDelete(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayMNQQueueDeleteSQS(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := SQSClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, _, queueName, err := decomposeMNQQueueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	queue, err := client.GetQueueUrlWithContext(ctx, &sqs.GetQueueUrlInput{
@@ -547,17 +566,17 @@ func resourceScalewayMNQQueueDeleteSQS(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayMNQQueueDeleteNATS(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, _, err := NATSClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, _, queueName, err := decomposeMNQQueueID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = client.DeleteStream(queueName)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -107,7 +107,7 @@ func resourceScalewayObject() *schema.Resource {
 func resourceScalewayObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, region, err := s3ClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
@@ -127,7 +127,7 @@ func resourceScalewayObjectCreate(ctx context.Context, d *schema.ResourceData, m
 	if filePath, hasFile := d.GetOk("file"); hasFile {
 		file, err := os.Open(filePath.(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		req.Body = file
 	} else if content, hasContent := d.GetOk("content"); hasContent {
@@ -138,7 +138,7 @@ func resourceScalewayObjectCreate(ctx context.Context, d *schema.ResourceData, m
 		decoded := make([]byte, base64.StdEncoding.DecodedLen(len(contentString)))
 		_, err = base64.StdEncoding.Decode(decoded, contentString)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		req.Body = bytes.NewReader(decoded)
 	} else {
@@ -147,7 +147,7 @@ func resourceScalewayObjectCreate(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = s3Client.PutObjectWithContext(ctx, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if rawTags, hasTags := d.GetOk("tags"); hasTags {
@@ -159,7 +159,7 @@ func resourceScalewayObjectCreate(ctx context.Context, d *schema.ResourceData, m
 			},
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -171,7 +171,7 @@ func resourceScalewayObjectCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceScalewayObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, region, key, bucket, err := s3ClientWithRegionAndNestedName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
@@ -189,7 +189,7 @@ func resourceScalewayObjectUpdate(ctx context.Context, d *schema.ResourceData, m
 		if filePath, hasFile := d.GetOk("file"); hasFile {
 			file, err := os.Open(filePath.(string))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			req.Body = file
 		} else {
@@ -207,7 +207,7 @@ func resourceScalewayObjectUpdate(ctx context.Context, d *schema.ResourceData, m
 		})
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChanges("key", "bucket") {
@@ -216,7 +216,7 @@ func resourceScalewayObjectUpdate(ctx context.Context, d *schema.ResourceData, m
 			Bucket: scw.StringPtr(bucket),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -229,7 +229,7 @@ func resourceScalewayObjectUpdate(ctx context.Context, d *schema.ResourceData, m
 			},
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -241,7 +241,7 @@ func resourceScalewayObjectUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceScalewayObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, region, key, bucket, err := s3ClientWithRegionAndNestedName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
@@ -252,7 +252,7 @@ func resourceScalewayObjectRead(ctx context.Context, d *schema.ResourceData, met
 		Key:    expandStringPtr(key),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("region", region)
@@ -272,7 +272,7 @@ func resourceScalewayObjectRead(ctx context.Context, d *schema.ResourceData, met
 		Key:    expandStringPtr(key),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("tags", flattenObjectBucketTags(tags.TagSet))
@@ -282,7 +282,7 @@ func resourceScalewayObjectRead(ctx context.Context, d *schema.ResourceData, met
 		Key:    expandStringPtr(key),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if objectIsPublic(acl) {
@@ -297,7 +297,7 @@ func resourceScalewayObjectRead(ctx context.Context, d *schema.ResourceData, met
 func resourceScalewayObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, _, key, bucket, err := s3ClientWithRegionAndNestedName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
@@ -310,7 +310,7 @@ func resourceScalewayObjectDelete(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = s3Client.DeleteObjectWithContext(ctx, req)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
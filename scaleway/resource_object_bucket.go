@@ -224,7 +224,7 @@ func resourceScalewayObjectBucketCreate(ctx context.Context, d *schema.ResourceD
 
 	s3Client, region, err := s3ClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &s3.CreateBucketInput{
@@ -237,7 +237,7 @@ func resourceScalewayObjectBucketCreate(ctx context.Context, d *schema.ResourceD
 		_, err = s3Client.CreateBucketWithContext(ctx, req)
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tagsSet := expandObjectBucketTags(d.Get("tags"))
@@ -250,7 +250,7 @@ func resourceScalewayObjectBucketCreate(ctx context.Context, d *schema.ResourceD
 			},
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -262,7 +262,7 @@ func resourceScalewayObjectBucketCreate(ctx context.Context, d *schema.ResourceD
 func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, _, bucketName, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("acl") {
@@ -274,7 +274,7 @@ func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceD
 		})
 		if err != nil {
 			tflog.Error(ctx, fmt.Sprintf("Couldn't update bucket ACL: %s", err))
-			return diag.FromErr(fmt.Errorf("couldn't update bucket ACL: %s", err))
+			return diagFromErr(fmt.Errorf("couldn't update bucket ACL: %s", err))
 		}
 	}
 
@@ -282,7 +282,7 @@ func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceD
 	objectLockEnabled := d.Get("object_lock_enabled").(bool)
 	if !objectLockEnabled && d.HasChange("versioning") {
 		if err := resourceScalewayObjectBucketVersioningUpdate(ctx, s3Client, d); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -302,19 +302,19 @@ func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceD
 			})
 		}
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("cors_rule") {
 		if err := resourceScalewayS3BucketCorsUpdate(ctx, s3Client, d); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("lifecycle_rule") {
 		if err := resourceBucketLifecycleUpdate(ctx, s3Client, d); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -451,7 +451,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.S3, d *schema.R
 func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, region, bucketName, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", bucketName)
@@ -461,7 +461,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 		Bucket: aws.String(bucketName),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
 	}
 	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
 
@@ -478,7 +478,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		default:
-			return diag.FromErr(fmt.Errorf("couldn't read bucket: %s", err))
+			return diagFromErr(fmt.Errorf("couldn't read bucket: %s", err))
 		}
 	} else if objectLockConfiguration.ObjectLockConfiguration != nil {
 		_ = d.Set("object_lock_enabled", true)
@@ -502,7 +502,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("couldn't read bucket: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket: %s", err))
 	}
 
 	var tagsSet []*s3.Tag
@@ -512,7 +512,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 	})
 	if err != nil {
 		if s3err, ok := err.(awserr.Error); !ok || s3err.Code() != ErrCodeNoSuchTagSet {
-			return diag.FromErr(fmt.Errorf("couldn't read tags from bucket: %s", err))
+			return diagFromErr(fmt.Errorf("couldn't read tags from bucket: %s", err))
 		}
 	} else {
 		tagsSet = tagsResponse.TagSet
@@ -528,7 +528,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 	})
 
 	if err != nil && !isS3Err(err, ErrCodeNoSuchCORSConfiguration, "") {
-		return diag.FromErr(fmt.Errorf("error getting S3 Bucket CORS configuration: %s", err))
+		return diagFromErr(fmt.Errorf("error getting S3 Bucket CORS configuration: %s", err))
 	}
 
 	_ = d.Set("cors_rule", flattenBucketCORS(corsResponse))
@@ -540,7 +540,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 		Bucket: scw.StringPtr(bucketName),
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("versioning", flattenObjectBucketVersioning(versioningResponse))
 
@@ -555,7 +555,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 		},
 	})
 	if err != nil && !tfawserr.ErrMessageContains(err, ErrCodeNoSuchLifecycleConfiguration, "") {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lifecycleRules := make([]map[string]interface{}, 0)
@@ -641,7 +641,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 	if err := d.Set("lifecycle_rule", lifecycleRules); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting lifecycle_rule: %s", err))
+		return diagFromErr(fmt.Errorf("error setting lifecycle_rule: %s", err))
 	}
 
 	return nil
@@ -650,7 +650,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayObjectBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, _, bucketName, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = s3Client.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
@@ -665,14 +665,14 @@ func resourceScalewayObjectBucketDelete(ctx context.Context, d *schema.ResourceD
 		if d.Get("force_destroy").(bool) {
 			err = deleteS3ObjectVersions(ctx, s3Client, bucketName, true)
 			if err != nil {
-				return diag.FromErr(fmt.Errorf("error S3 bucket force_destroy: %s", err))
+				return diagFromErr(fmt.Errorf("error S3 bucket force_destroy: %s", err))
 			}
 			// Try to delete bucket again after deleting objects
 			return resourceScalewayObjectBucketDelete(ctx, d, meta)
 		}
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -139,7 +139,7 @@ func resourceScalewayObjectBucketACL() *schema.Resource {
 func resourceBucketACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, region, err := s3ClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	bucket := expandID(d.Get("bucket").(string))
@@ -170,7 +170,7 @@ func resourceBucketACLCreate(ctx context.Context, d *schema.ResourceData, meta i
 		},
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error putting Object Storage ACL: %s", err))
+		return diagFromErr(fmt.Errorf("error putting Object Storage ACL: %s", err))
 	}
 	tflog.Debug(ctx, fmt.Sprintf("output: %v", out))
 
@@ -359,7 +359,7 @@ func resourceBucketACLRead(ctx context.Context, d *schema.ResourceData, meta int
 	expectedBucketOwner := d.Get("expected_bucket_owner")
 	conn, region, bucket, acl, err := s3ClientWithRegionWithNameACL(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &s3.GetBucketAclInput{
@@ -379,17 +379,17 @@ func resourceBucketACLRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error getting object storage bucket ACL (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error getting object storage bucket ACL (%s): %w", d.Id(), err))
 	}
 
 	if output == nil {
-		return diag.FromErr(fmt.Errorf("error getting object bucket ACL (%s): empty output", d.Id()))
+		return diagFromErr(fmt.Errorf("error getting object bucket ACL (%s): empty output", d.Id()))
 	}
 
 	_ = d.Set("acl", acl)
 	_ = d.Set("expected_bucket_owner", expectedBucketOwner)
 	if err := d.Set("access_control_policy", flattenBucketACLAccessControlPolicy(output)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting access_control_policy: %w", err))
+		return diagFromErr(fmt.Errorf("error setting access_control_policy: %w", err))
 	}
 	_ = d.Set("region", region)
 	_ = d.Set("project_id", normalizeOwnerID(output.Owner.ID))
@@ -410,7 +410,7 @@ func BucketACLCreateResourceID(region scw.Region, bucket, acl string) string {
 func resourceBucketACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, region, bucket, acl, err := s3ClientWithRegionWithNameACL(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &s3.PutBucketAclInput{
@@ -433,7 +433,7 @@ func resourceBucketACLUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	_, err = conn.PutBucketAclWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating object bucket ACL (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error updating object bucket ACL (%s): %w", d.Id(), err))
 	}
 
 	if d.HasChange("acl") {
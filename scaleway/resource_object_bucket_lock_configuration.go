@@ -79,7 +79,7 @@ func resourceObjectLockConfiguration() *schema.Resource {
 func resourceObjectLockConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, region, err := s3ClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	bucket := expandID(d.Get("bucket").(string))
@@ -100,7 +100,7 @@ func resourceObjectLockConfigurationCreate(ctx context.Context, d *schema.Resour
 		},
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) lock configuration: %w", bucket, err))
+		return diagFromErr(fmt.Errorf("error creating object bucket (%s) lock configuration: %w", bucket, err))
 	}
 
 	d.SetId(newRegionalIDString(region, bucket))
@@ -111,7 +111,7 @@ func resourceObjectLockConfigurationCreate(ctx context.Context, d *schema.Resour
 func resourceObjectLockConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &s3.GetObjectLockConfigurationInput{
@@ -127,7 +127,7 @@ func resourceObjectLockConfigurationRead(ctx context.Context, d *schema.Resource
 
 	if output == nil {
 		if d.IsNewResource() {
-			return diag.FromErr(fmt.Errorf("error reading object bucket lock configuration (%s): empty output", d.Id()))
+			return diagFromErr(fmt.Errorf("error reading object bucket lock configuration (%s): empty output", d.Id()))
 		}
 
 		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Lock Configuration (%s) not found, removing from state", d.Id()))
@@ -139,7 +139,7 @@ func resourceObjectLockConfigurationRead(ctx context.Context, d *schema.Resource
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
 	}
 	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
 
@@ -152,7 +152,7 @@ func resourceObjectLockConfigurationRead(ctx context.Context, d *schema.Resource
 func resourceObjectLockConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	lockConfig := &s3.ObjectLockConfiguration{
@@ -168,7 +168,7 @@ func resourceObjectLockConfigurationUpdate(ctx context.Context, d *schema.Resour
 	_, err = conn.PutObjectLockConfigurationWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Object bucket lock configuration (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error updating Object bucket lock configuration (%s): %w", d.Id(), err))
 	}
 
 	return resourceObjectLockConfigurationRead(ctx, d, meta)
@@ -177,7 +177,7 @@ func resourceObjectLockConfigurationUpdate(ctx context.Context, d *schema.Resour
 func resourceObjectLockConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &s3.PutObjectLockConfigurationInput{
@@ -194,7 +194,7 @@ func resourceObjectLockConfigurationDelete(ctx context.Context, d *schema.Resour
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Object bucket lock configuration (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting Object bucket lock configuration (%s): %w", d.Id(), err))
 	}
 
 	return nil
@@ -0,0 +1,258 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceScalewayObjectBucketNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayObjectBucketNotificationCreate,
+		ReadContext:   resourceScalewayObjectBucketNotificationRead,
+		UpdateContext: resourceScalewayObjectBucketNotificationUpdate,
+		DeleteContext: resourceScalewayObjectBucketNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+				Description:  "The bucket name.",
+			},
+			"queue": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The unique identifier of the notification.",
+						},
+						"queue_arn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ARN of the SQS queue the events are sent to.",
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Set:         schema.HashString,
+							Description: "The bucket events that trigger the notification (e.g. s3:ObjectCreated:*).",
+						},
+						"filter_prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Only objects with a key starting with this prefix trigger the notification.",
+						},
+						"filter_suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Only objects with a key ending with this suffix trigger the notification.",
+						},
+					},
+				},
+				Description: "Notifications sent to an SQS queue.",
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func resourceScalewayObjectBucketNotificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	bucket := expandID(d.Get("bucket").(string))
+
+	input := &s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+		NotificationConfiguration: &s3.NotificationConfiguration{
+			QueueConfigurations: expandBucketNotificationQueueConfigurations(d.Get("queue").([]interface{})),
+		},
+	}
+
+	_, err = retryWhenAWSErrCodeEquals(ctx, []string{s3.ErrCodeNoSuchBucket}, &RetryWhenConfig[*s3.PutBucketNotificationConfigurationOutput]{
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Interval: 5 * time.Second,
+		Function: func() (*s3.PutBucketNotificationConfigurationOutput, error) {
+			return conn.PutBucketNotificationConfigurationWithContext(ctx, input)
+		},
+	})
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error creating object bucket (%s) notification: %w", bucket, err))
+	}
+
+	d.SetId(newRegionalIDString(region, bucket))
+
+	return resourceScalewayObjectBucketNotificationRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketNotificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	output, err := conn.GetBucketNotificationConfigurationWithContext(ctx, &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(bucket),
+	})
+	if !d.IsNewResource() && isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Notification (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error reading object bucket notification (%s): %w", d.Id(), err))
+	}
+
+	if len(output.QueueConfigurations) == 0 && !d.IsNewResource() {
+		tflog.Warn(ctx, fmt.Sprintf("Object Bucket Notification (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	acl, err := conn.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return diagFromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+	}
+	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
+
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("queue", flattenBucketNotificationQueueConfigurations(output.QueueConfigurations))
+
+	return nil
+}
+
+func resourceScalewayObjectBucketNotificationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	_, err = conn.PutBucketNotificationConfigurationWithContext(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+		NotificationConfiguration: &s3.NotificationConfiguration{
+			QueueConfigurations: expandBucketNotificationQueueConfigurations(d.Get("queue").([]interface{})),
+		},
+	})
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error updating object bucket notification (%s): %w", d.Id(), err))
+	}
+
+	return resourceScalewayObjectBucketNotificationRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketNotificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	// An empty configuration disables all the notifications of the bucket
+	_, err = conn.PutBucketNotificationConfigurationWithContext(ctx, &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: &s3.NotificationConfiguration{},
+	})
+	if isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+	if err != nil {
+		return diagFromErr(fmt.Errorf("error deleting object bucket notification (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandBucketNotificationQueueConfigurations(l []interface{}) []*s3.QueueConfiguration {
+	configurations := []*s3.QueueConfiguration(nil)
+	for _, raw := range l {
+		tfMap, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		configuration := &s3.QueueConfiguration{
+			QueueArn: aws.String(tfMap["queue_arn"].(string)),
+			Events:   aws.StringSlice(expandStrings(tfMap["events"].(*schema.Set).List())),
+		}
+		if id, ok := tfMap["id"].(string); ok && id != "" {
+			configuration.Id = aws.String(id)
+		}
+
+		var filterRules []*s3.FilterRule
+		if prefix, ok := tfMap["filter_prefix"].(string); ok && prefix != "" {
+			filterRules = append(filterRules, &s3.FilterRule{
+				Name:  aws.String(s3.FilterRuleNamePrefix),
+				Value: aws.String(prefix),
+			})
+		}
+		if suffix, ok := tfMap["filter_suffix"].(string); ok && suffix != "" {
+			filterRules = append(filterRules, &s3.FilterRule{
+				Name:  aws.String(s3.FilterRuleNameSuffix),
+				Value: aws.String(suffix),
+			})
+		}
+		if len(filterRules) > 0 {
+			configuration.Filter = &s3.NotificationConfigurationFilter{
+				Key: &s3.KeyFilter{
+					FilterRules: filterRules,
+				},
+			}
+		}
+
+		configurations = append(configurations, configuration)
+	}
+
+	return configurations
+}
+
+func flattenBucketNotificationQueueConfigurations(configurations []*s3.QueueConfiguration) []interface{} {
+	flat := []interface{}(nil)
+	for _, configuration := range configurations {
+		m := map[string]interface{}{
+			"id":        aws.StringValue(configuration.Id),
+			"queue_arn": aws.StringValue(configuration.QueueArn),
+			"events":    flattenSliceString(aws.StringValueSlice(configuration.Events)),
+		}
+
+		if configuration.Filter != nil && configuration.Filter.Key != nil {
+			for _, rule := range configuration.Filter.Key.FilterRules {
+				// Rule names are case-insensitive
+				switch strings.ToLower(aws.StringValue(rule.Name)) {
+				case s3.FilterRuleNamePrefix:
+					m["filter_prefix"] = aws.StringValue(rule.Value)
+				case s3.FilterRuleNameSuffix:
+					m["filter_suffix"] = aws.StringValue(rule.Value)
+				}
+			}
+		}
+
+		flat = append(flat, m)
+	}
+
+	return flat
+}
@@ -0,0 +1,209 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccScalewayObjectBucketNotificationConfig(bucketName string, notification string) string {
+	return fmt.Sprintf(`
+		resource "scaleway_mnq_namespace" "main" {
+			name     = "test-object-bucket-notification"
+			protocol = "sqs_sns"
+		}
+
+		resource "scaleway_mnq_credential" "main" {
+			name         = "test-object-bucket-notification"
+			namespace_id = scaleway_mnq_namespace.main.id
+			sqs_sns_credentials {
+				permissions {
+					can_publish = true
+					can_receive = true
+					can_manage  = true
+				}
+			}
+		}
+
+		resource "scaleway_mnq_queue" "main" {
+			name         = "test-object-bucket-notification"
+			namespace_id = scaleway_mnq_namespace.main.id
+
+			sqs {
+				access_key = scaleway_mnq_credential.main.sqs_sns_credentials[0].access_key
+				secret_key = scaleway_mnq_credential.main.sqs_sns_credentials[0].secret_key
+			}
+		}
+
+		resource "scaleway_object_bucket" "main" {
+			name = %[1]q
+		}
+
+		resource "scaleway_object_bucket_notification" "main" {
+			bucket = scaleway_object_bucket.main.name
+			%[2]s
+		}
+	`, bucketName, notification)
+}
+
+func TestAccScalewayObjectBucketNotification_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("tf-test-bucket-notification")
+	resourceName := "scaleway_object_bucket_notification.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ErrorCheck:        ErrorCheck(t, EndpointsID),
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayObjectBucketNotificationDestroy(tt),
+			testAccCheckScalewayObjectBucketDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScalewayObjectBucketNotificationConfig(bucketName, `
+					queue {
+						queue_arn = scaleway_mnq_queue.main.sqs.0.arn
+						events    = ["s3:ObjectCreated:*"]
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayObjectBucketNotificationExists(tt, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "scaleway_object_bucket.main", "name"),
+					resource.TestCheckResourceAttr(resourceName, "queue.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue.0.queue_arn", "scaleway_mnq_queue.main", "sqs.0.arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "queue.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "queue.0.events.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "queue.0.events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckResourceAttr(resourceName, "queue.0.filter_prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "queue.0.filter_suffix", ""),
+				),
+			},
+			{
+				Config: testAccScalewayObjectBucketNotificationConfig(bucketName, `
+					queue {
+						queue_arn     = scaleway_mnq_queue.main.sqs.0.arn
+						events        = ["s3:ObjectCreated:*", "s3:ObjectRemoved:*"]
+						filter_prefix = "images/"
+						filter_suffix = ".png"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayObjectBucketNotificationExists(tt, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "queue.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "queue.0.events.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "queue.0.events.*", "s3:ObjectCreated:*"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "queue.0.events.*", "s3:ObjectRemoved:*"),
+					resource.TestCheckResourceAttr(resourceName, "queue.0.filter_prefix", "images/"),
+					resource.TestCheckResourceAttr(resourceName, "queue.0.filter_suffix", ".png"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestExpandFlattenBucketNotificationQueueConfigurations(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"id":            "thumbnails",
+			"queue_arn":     "arn:scw:sqs:fr-par:project-11111111-1111-1111-1111-111111111111:thumbnails",
+			"events":        schema.NewSet(schema.HashString, []interface{}{"s3:ObjectCreated:*"}),
+			"filter_prefix": "images/",
+			"filter_suffix": ".png",
+		},
+		map[string]interface{}{
+			"queue_arn": "arn:scw:sqs:fr-par:project-11111111-1111-1111-1111-111111111111:indexing",
+			"events":    schema.NewSet(schema.HashString, []interface{}{"s3:ObjectCreated:*", "s3:ObjectRemoved:*"}),
+		},
+	}
+
+	configurations := expandBucketNotificationQueueConfigurations(raw)
+	assert.Len(t, configurations, 2)
+	assert.Equal(t, "thumbnails", aws.StringValue(configurations[0].Id))
+	assert.Len(t, configurations[0].Filter.Key.FilterRules, 2)
+	assert.Nil(t, configurations[1].Id)
+	assert.Nil(t, configurations[1].Filter)
+
+	// The API may return the filter rule names capitalized
+	configurations[0].Filter.Key.FilterRules[0].Name = aws.String("Prefix")
+	configurations[0].Filter.Key.FilterRules[1].Name = aws.String("Suffix")
+
+	flat := flattenBucketNotificationQueueConfigurations(configurations)
+	assert.Len(t, flat, 2)
+	assert.Equal(t, "images/", flat[0].(map[string]interface{})["filter_prefix"])
+	assert.Equal(t, ".png", flat[0].(map[string]interface{})["filter_suffix"])
+	assert.Equal(t, "arn:scw:sqs:fr-par:project-11111111-1111-1111-1111-111111111111:indexing", flat[1].(map[string]interface{})["queue_arn"])
+	assert.ElementsMatch(t, []string{"s3:ObjectCreated:*", "s3:ObjectRemoved:*"}, flat[1].(map[string]interface{})["events"])
+	assert.NotContains(t, flat[1].(map[string]interface{}), "filter_prefix")
+}
+
+func testAccCheckScalewayObjectBucketNotificationExists(tt *TestTools, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn, err := newS3ClientFromMeta(tt.Meta)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(expandID(rs.Primary.ID)),
+		})
+		if err != nil {
+			return fmt.Errorf("error getting object bucket notification (%s): %w", rs.Primary.ID, err)
+		}
+
+		if len(output.QueueConfigurations) == 0 {
+			return fmt.Errorf("object bucket notification (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScalewayObjectBucketNotificationDestroy(tt *TestTools) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := newS3ClientFromMeta(tt.Meta)
+		if err != nil {
+			return err
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "scaleway_object_bucket_notification" {
+				continue
+			}
+
+			output, err := conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+				Bucket: aws.String(expandID(rs.Primary.ID)),
+			})
+			if isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("error getting object bucket notification (%s): %w", rs.Primary.ID, err)
+			}
+
+			if len(output.QueueConfigurations) != 0 {
+				return fmt.Errorf("object bucket notification (%s) still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
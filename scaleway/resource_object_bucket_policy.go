@@ -49,7 +49,7 @@ func resourceScalewayObjectBucketPolicy() *schema.Resource {
 func resourceScalewayObjectBucketPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, region, err := s3ClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	bucket := expandID(d.Get("bucket"))
@@ -57,7 +57,7 @@ func resourceScalewayObjectBucketPolicyCreate(ctx context.Context, d *schema.Res
 
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policy, err))
+		return diagFromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policy, err))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("[DEBUG] SCW bucket: %s, put policy: %s", bucket, policy))
@@ -82,7 +82,7 @@ func resourceScalewayObjectBucketPolicyCreate(ctx context.Context, d *schema.Res
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error putting SCW bucket policy: %s", err))
+		return diagFromErr(fmt.Errorf("error putting SCW bucket policy: %s", err))
 	}
 
 	d.SetId(newRegionalIDString(region, bucket))
@@ -94,7 +94,7 @@ func resourceScalewayObjectBucketPolicyCreate(ctx context.Context, d *schema.Res
 func resourceScalewayObjectBucketPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, region, _, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	bucket := expandID(d.Id())
@@ -119,28 +119,28 @@ func resourceScalewayObjectBucketPolicyRead(ctx context.Context, d *schema.Resou
 
 	policyToSet, err := SecondJSONUnlessEquivalent(d.Get("policy").(string), v)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err))
+		return diagFromErr(fmt.Errorf("while setting policy (%s), encountered: %w", policyToSet, err))
 	}
 
 	policyToSet, err = structure.NormalizeJsonString(policyToSet)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policyToSet, err))
+		return diagFromErr(fmt.Errorf("policy (%s) is an invalid JSON: %w", policyToSet, err))
 	}
 
 	if err := d.Set("policy", policyToSet); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if err := d.Set("bucket", bucket); err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	acl, err := s3Client.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
 	}
 	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
 
@@ -150,7 +150,7 @@ func resourceScalewayObjectBucketPolicyRead(ctx context.Context, d *schema.Resou
 func resourceScalewayObjectBucketPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	s3Client, _, bucketName, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("scw object bucket: %s, delete policy", bucketName))
@@ -163,7 +163,7 @@ func resourceScalewayObjectBucketPolicyDelete(ctx context.Context, d *schema.Res
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting SCW Object policy: %s", err))
+		return diagFromErr(fmt.Errorf("error deleting SCW Object policy: %s", err))
 	}
 
 	return nil
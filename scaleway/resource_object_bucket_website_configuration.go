@@ -81,7 +81,7 @@ func ResourceBucketWebsiteConfiguration() *schema.Resource {
 func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, region, err := s3ClientWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	bucket := expandID(d.Get("bucket").(string))
@@ -103,7 +103,7 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("couldn't read bucket: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket: %s", err))
 	}
 
 	input := &s3.PutBucketWebsiteInput{
@@ -119,7 +119,7 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 		},
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating object bucket (%s) website configuration: %w", bucket, err))
+		return diagFromErr(fmt.Errorf("error creating object bucket (%s) website configuration: %w", bucket, err))
 	}
 
 	d.SetId(newRegionalIDString(region, bucket))
@@ -130,7 +130,7 @@ func resourceBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.Res
 func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, region, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &s3.GetBucketWebsiteInput{
@@ -148,7 +148,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("couldn't read bucket: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket: %s", err))
 	}
 
 	output, err := conn.GetBucketWebsiteWithContext(ctx, input)
@@ -160,7 +160,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 
 	if output == nil {
 		if d.IsNewResource() {
-			return diag.FromErr(fmt.Errorf("error reading object bucket website configuration (%s): empty output", d.Id()))
+			return diagFromErr(fmt.Errorf("error reading object bucket website configuration (%s): empty output", d.Id()))
 		}
 		tflog.Info(ctx, fmt.Sprintf("[WARN] object Bucket Website Configuration (%s) not found, removing from state", d.Id()))
 		d.SetId("")
@@ -171,7 +171,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 	_ = d.Set("index_document", flattenBucketWebsiteConfigurationIndexDocument(output.IndexDocument))
 
 	if err := d.Set("error_document", flattenBucketWebsiteConfigurationErrorDocument(output.ErrorDocument)); err != nil {
-		return diag.FromErr(fmt.Errorf("error setting error_document: %w", err))
+		return diagFromErr(fmt.Errorf("error setting error_document: %w", err))
 	}
 
 	websiteEndpoint := WebsiteEndpoint(bucket, region)
@@ -185,7 +185,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 		Bucket: aws.String(bucket),
 	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+		return diagFromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
 	}
 	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
 
@@ -195,7 +195,7 @@ func resourceBucketWebsiteConfigurationRead(ctx context.Context, d *schema.Resou
 func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	websiteConfig := &s3.WebsiteConfiguration{
@@ -214,7 +214,7 @@ func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.Res
 	_, err = conn.PutBucketWebsiteWithContext(ctx, input)
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Object bucket website configuration (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error updating Object bucket website configuration (%s): %w", d.Id(), err))
 	}
 
 	return resourceBucketWebsiteConfigurationRead(ctx, d, meta)
@@ -223,7 +223,7 @@ func resourceBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.Res
 func resourceBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	input := &s3.DeleteBucketWebsiteInput{
@@ -237,7 +237,7 @@ func resourceBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.Res
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Object bucket website configuration (%s): %w", d.Id(), err))
+		return diagFromErr(fmt.Errorf("error deleting Object bucket website configuration (%s): %w", d.Id(), err))
 	}
 
 	return nil
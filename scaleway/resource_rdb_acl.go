@@ -71,18 +71,18 @@ func resourceScalewayRdbACL() *schema.Resource {
 func resourceScalewayRdbACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	instanceID := d.Get("instance_id").(string)
 	_, err = waitForRDBInstance(ctx, api, region, expandID(instanceID), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	aclRules, err := rdbACLExpand(d.Get("acl_rules").([]interface{}))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	createReq := &rdb.SetInstanceACLRulesRequest{
 		Region:     region,
@@ -92,7 +92,7 @@ func resourceScalewayRdbACLCreate(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = api.SetInstanceACLRules(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(instanceID)
@@ -103,25 +103,25 @@ func resourceScalewayRdbACLCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceScalewayRdbACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	instanceID := expandID(d.Get("instance_id").(string))
 	_, err = waitForRDBInstance(ctx, api, region, instanceID, d.Timeout(schema.TimeoutRead))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := api.ListInstanceACLRules(&rdb.ListInstanceACLRulesRequest{
 		Region:     region,
 		InstanceID: instanceID,
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
 		if is404Error(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	id := newRegionalID(region, instanceID).String()
@@ -154,23 +154,23 @@ func resourceScalewayRdbACLRead(ctx context.Context, d *schema.ResourceData, met
 func resourceScalewayRdbACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, instanceID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChange("acl_rules") {
 		_, err := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		aclRules, err := rdbACLExpand(d.Get("acl_rules").([]interface{}))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		req := &rdb.SetInstanceACLRulesRequest{
 			Region:     region,
@@ -180,7 +180,7 @@ func resourceScalewayRdbACLUpdate(ctx context.Context, d *schema.ResourceData, m
 
 		_, err = rdbAPI.SetInstanceACLRules(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -190,12 +190,12 @@ func resourceScalewayRdbACLUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceScalewayRdbACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, instanceID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	aclRuleIPs := make([]string, 0)
 	aclRules, err := rdbACLExpand(d.Get("acl_rules").([]interface{}))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	for _, acl := range aclRules {
 		aclRuleIPs = append(aclRuleIPs, acl.IP.String())
@@ -203,7 +203,7 @@ func resourceScalewayRdbACLDelete(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = rdbAPI.DeleteInstanceACLRules(&rdb.DeleteInstanceACLRulesRequest{
@@ -212,12 +212,12 @@ func resourceScalewayRdbACLDelete(ctx context.Context, d *schema.ResourceData, m
 		ACLRuleIPs: aclRuleIPs,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
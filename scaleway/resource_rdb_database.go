@@ -82,12 +82,12 @@ func resourceScalewayRdbDatabaseCreate(ctx context.Context, d *schema.ResourceDa
 	rdbAPI := newRdbAPI(meta)
 	region, instanceID, err := parseRegionalID(d.Get("instance_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	createReq := &rdb.CreateDatabaseRequest{
@@ -112,12 +112,12 @@ func resourceScalewayRdbDatabaseCreate(ctx context.Context, d *schema.ResourceDa
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(resourceScalewayRdbDatabaseID(region, instanceID, db.Name))
@@ -131,7 +131,7 @@ func getDatabase(ctx context.Context, api *rdb.API, r scw.Region, instanceID, db
 		Region:     r,
 		InstanceID: instanceID,
 		Name:       &dbName,
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +147,7 @@ func resourceScalewayRdbDatabaseRead(ctx context.Context, d *schema.ResourceData
 	rdbAPI := newRdbAPI(meta)
 	region, instanceID, databaseName, err := resourceScalewayRdbDatabaseParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	database, err := getDatabase(ctx, rdbAPI, region, instanceID, databaseName)
@@ -156,7 +156,7 @@ func resourceScalewayRdbDatabaseRead(ctx context.Context, d *schema.ResourceData
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(resourceScalewayRdbDatabaseID(region, instanceID, database.Name))
@@ -173,12 +173,12 @@ func resourceScalewayRdbDatabaseDelete(ctx context.Context, d *schema.ResourceDa
 	rdbAPI := newRdbAPI(meta)
 	region, instanceID, databaseName, err := resourceScalewayRdbDatabaseParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = rdbAPI.DeleteDatabase(&rdb.DeleteDatabaseRequest{
@@ -187,12 +187,12 @@ func resourceScalewayRdbDatabaseDelete(ctx context.Context, d *schema.ResourceDa
 		Name:       databaseName,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,9 +27,18 @@ func resourceScalewayRdbInstance() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultRdbInstanceTimeout),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findRdbInstanceIDByName),
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type: cty.Object(map[string]cty.Type{
+					"private_network": cty.List(cty.Object(map[string]cty.Type{"pn_id": cty.String})),
+				}),
+				Upgrade: vpcPrivateNetworkIDsUpgradeFunc("private_network.#.pn_id"),
+			},
 		},
-		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -41,6 +51,7 @@ func resourceScalewayRdbInstance() *schema.Resource {
 				Required:         true,
 				Description:      "The type of database instance you want to create",
 				DiffSuppressFunc: diffSuppressFuncIgnoreCase,
+				ValidateDiagFunc: validateStringFormat(commercialTypeFormat, "node_type"),
 			},
 			"engine": {
 				Type:        schema.TypeString,
@@ -78,6 +89,31 @@ func resourceScalewayRdbInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Boolean to store logical backups in the same region as the database instance",
 			},
+			"logs_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Logs policy of the database instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_age_retention": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Max age in days of remote logs to keep on the database instance",
+						},
+						"total_disk_retention": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Max disk size in bytes of remote logs to keep on the database instance",
+						},
+					},
+				},
+			},
 			"user_name": {
 				Type:        schema.TypeString,
 				ForceNew:    true,
@@ -108,6 +144,11 @@ func resourceScalewayRdbInstance() *schema.Resource {
 				ForceNew:    true,
 				Optional:    true,
 			},
+			"async": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Do not wait for the database instance to be ready after creation",
+			},
 			"tags": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
@@ -262,6 +303,40 @@ func resourceScalewayRdbInstance() *schema.Resource {
 					},
 				},
 			},
+			"maintenances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the maintenances of the database instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"starts_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start date of the maintenance window",
+						},
+						"stops_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End date of the maintenance window",
+						},
+						"closed_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date at which the maintenance was closed",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Maintenance information message",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the maintenance",
+						},
+					},
+				},
+			},
 			// Common
 			"region":          regionSchema(),
 			"organization_id": organizationIDSchema(),
@@ -274,7 +349,7 @@ func resourceScalewayRdbInstance() *schema.Resource {
 func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	createReq := &rdb.CreateInstanceRequest{
@@ -303,7 +378,7 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 	if pnExist {
 		createReq.InitEndpoints, err = expandPrivateNetwork(pn, pnExist)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	} else {
 		createReq.InitEndpoints = expandLoadBalancer()
@@ -311,14 +386,14 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 
 	if size, ok := d.GetOk("volume_size_in_gb"); ok {
 		if createReq.VolumeType != rdb.VolumeTypeBssd {
-			return diag.FromErr(fmt.Errorf("volume_size_in_gb should be used with volume_type %s only", rdb.VolumeTypeBssd.String()))
+			return diagFromErr(fmt.Errorf("volume_size_in_gb should be used with volume_type %s only", rdb.VolumeTypeBssd.String()))
 		}
 		createReq.VolumeSize = scw.Size(uint64(size.(int)) * uint64(scw.GB))
 	}
 
 	res, err := rdbAPI.CreateInstance(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, res.ID))
@@ -343,19 +418,36 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = rdbAPI.UpdateInstance(updateReq, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
+		}
+	}
+	// Configure logs policy
+	// LogsPolicy can only be configured after instance creation
+	if logsPolicy, ok := d.GetOk("logs_policy"); ok {
+		_, err = waitForRDBInstance(ctx, rdbAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diagFromErr(err)
+		}
+
+		_, err = rdbAPI.UpdateInstance(&rdb.UpdateInstanceRequest{
+			Region:     region,
+			InstanceID: res.ID,
+			LogsPolicy: expandRdbInstanceLogsPolicy(logsPolicy),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diagFromErr(err)
 		}
 	}
 	// Configure Instance settings
 	if settings, ok := d.GetOk("settings"); ok {
 		res, err = waitForRDBInstance(ctx, rdbAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err := rdbAPI.SetInstanceSettings(&rdb.SetInstanceSettingsRequest{
@@ -364,7 +456,7 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 			Settings:   expandInstanceSettings(settings),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -374,17 +466,25 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayRdbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
-	// verify resource is ready
-	res, err := waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
+	var res *rdb.Instance
+	if d.Get("async").(bool) {
+		res, err = rdbAPI.GetInstance(&rdb.GetInstanceRequest{
+			Region:     region,
+			InstanceID: ID,
+		}, scw.WithContext(ctx))
+	} else {
+		// verify resource is ready
+		res, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
+	}
 	if err != nil {
 		if is404Error(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -422,11 +522,11 @@ func resourceScalewayRdbInstanceRead(ctx context.Context, d *schema.ResourceData
 		InstanceID: ID,
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	certContent, err := ioutil.ReadAll(cert.Content)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	_ = d.Set("certificate", string(certContent))
 
@@ -440,6 +540,8 @@ func resourceScalewayRdbInstanceRead(ctx context.Context, d *schema.ResourceData
 		_ = d.Set("private_network", pnI)
 	}
 	_ = d.Set("load_balancer", flattenLoadBalancer(res.Endpoints))
+	_ = d.Set("maintenances", flattenRdbInstanceMaintenances(res.Maintenances))
+	_ = d.Set("logs_policy", flattenRdbInstanceLogsPolicy(res.LogsPolicy))
 
 	return nil
 }
@@ -448,7 +550,7 @@ func resourceScalewayRdbInstanceRead(ctx context.Context, d *schema.ResourceData
 func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &rdb.UpdateInstanceRequest{
@@ -474,21 +576,24 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 	if d.HasChange("tags") {
 		req.Tags = expandUpdatedStringsPtr(d.Get("tags"))
 	}
+	if d.HasChange("logs_policy") {
+		req.LogsPolicy = expandRdbInstanceLogsPolicy(d.Get("logs_policy"))
+	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = rdbAPI.UpdateInstance(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	// Change settings
 	if d.HasChange("settings") {
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		_, err := rdbAPI.SetInstanceSettings(&rdb.SetInstanceSettingsRequest{
 			InstanceID: ID,
@@ -496,7 +601,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 			Settings:   expandInstanceSettings(d.Get("settings")),
 		}, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -519,11 +624,11 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 				oldSize := uint64(oldSizeInterface.(int))
 				newSize := uint64(newSizeInterface.(int))
 				if newSize < oldSize {
-					return diag.FromErr(fmt.Errorf("volume_size_in_gb cannot be decreased"))
+					return diagFromErr(fmt.Errorf("volume_size_in_gb cannot be decreased"))
 				}
 
 				if newSize%5 != 0 {
-					return diag.FromErr(fmt.Errorf("volume_size_in_gb must be a multiple of 5"))
+					return diagFromErr(fmt.Errorf("volume_size_in_gb must be a multiple of 5"))
 				}
 
 				upgradeInstanceRequests = append(upgradeInstanceRequests,
@@ -536,7 +641,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		case rdb.VolumeTypeLssd:
 			_, ok := d.GetOk("volume_size_in_gb")
 			if d.HasChange("volume_size_in_gb") && ok {
-				return diag.FromErr(fmt.Errorf("volume_size_in_gb should be used with volume_type %s only", rdb.VolumeTypeBssd.String()))
+				return diagFromErr(fmt.Errorf("volume_size_in_gb should be used with volume_type %s only", rdb.VolumeTypeBssd.String()))
 			}
 			if d.HasChange("volume_type") {
 				upgradeInstanceRequests = append(upgradeInstanceRequests,
@@ -547,7 +652,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 					})
 			}
 		default:
-			return diag.FromErr(fmt.Errorf("unknown volume_type %s", volType.String()))
+			return diagFromErr(fmt.Errorf("unknown volume_type %s", volType.String()))
 		}
 	}
 
@@ -571,24 +676,24 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 	for i := range upgradeInstanceRequests {
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = rdbAPI.UpgradeInstance(&upgradeInstanceRequests[i], scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	if d.HasChange("password") {
 		_, err := waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		req := &rdb.UpdateUserRequest{
@@ -600,7 +705,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 
 		_, err = rdbAPI.UpdateUser(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -608,7 +713,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		// retrieve state
 		res, err := waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		for _, e := range res.Endpoints {
@@ -619,7 +724,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 					},
 					scw.WithContext(ctx))
 				if err != nil {
-					diag.FromErr(err)
+					diagFromErr(err)
 				}
 			}
 		}
@@ -627,7 +732,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		// retrieve state
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		// set new endpoints
@@ -635,14 +740,14 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		if pnExist {
 			privateEndpoints, err := expandPrivateNetwork(pn, pnExist)
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			for _, e := range privateEndpoints {
 				_, err := rdbAPI.CreateEndpoint(
 					&rdb.CreateEndpointRequest{Region: region, InstanceID: ID, EndpointSpec: e},
 					scw.WithContext(ctx))
 				if err != nil {
-					return diag.FromErr(err)
+					return diagFromErr(err)
 				}
 			}
 		}
@@ -654,13 +759,13 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 func resourceScalewayRdbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// We first wait in case the instance is in a transient state
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = rdbAPI.DeleteInstance(&rdb.DeleteInstanceRequest{
@@ -669,13 +774,13 @@ func resourceScalewayRdbInstanceDelete(ctx context.Context, d *schema.ResourceDa
 	}, scw.WithContext(ctx))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Lastly wait in case the instance is in a transient state
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -137,7 +137,7 @@ func resourceScalewayRdbReadReplica() *schema.Resource {
 func resourceScalewayRdbReadReplicaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	endpointSpecs := []*rdb.ReadReplicaEndpointSpec(nil)
@@ -146,7 +146,7 @@ func resourceScalewayRdbReadReplicaCreate(ctx context.Context, d *schema.Resourc
 	}
 	if pn, err := expandReadReplicaEndpointsSpecPrivateNetwork(d.Get("private_network")); err != nil || pn != nil {
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		endpointSpecs = append(endpointSpecs, pn)
 	}
@@ -157,14 +157,14 @@ func resourceScalewayRdbReadReplicaCreate(ctx context.Context, d *schema.Resourc
 		EndpointSpec: endpointSpecs,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to create read-replica: %w", err))
+		return diagFromErr(fmt.Errorf("failed to create read-replica: %w", err))
 	}
 
 	d.SetId(newRegionalIDString(region, rr.ID))
 
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, rr.ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayRdbReadReplicaRead(ctx, d, meta)
@@ -173,7 +173,7 @@ func resourceScalewayRdbReadReplicaCreate(ctx context.Context, d *schema.Resourc
 func resourceScalewayRdbReadReplicaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	rr, err := waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
@@ -182,7 +182,7 @@ func resourceScalewayRdbReadReplicaRead(ctx context.Context, d *schema.ResourceD
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	directAccess, privateNetwork := flattenReadReplicaEndpoints(rr.Endpoints)
@@ -198,7 +198,7 @@ func resourceScalewayRdbReadReplicaRead(ctx context.Context, d *schema.ResourceD
 func resourceScalewayRdbReadReplicaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// verify resource is ready
@@ -208,7 +208,7 @@ func resourceScalewayRdbReadReplicaUpdate(ctx context.Context, d *schema.Resourc
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	newEndpoints := []*rdb.ReadReplicaEndpointSpec(nil)
@@ -224,7 +224,7 @@ func resourceScalewayRdbReadReplicaUpdate(ctx context.Context, d *schema.Resourc
 				EndpointID: expandID(d.Get("direct_access.0.endpoint_id")),
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		} else {
 			newEndpoints = append(newEndpoints, expandReadReplicaEndpointsSpecDirectAccess(d.Get("direct_access")))
@@ -239,12 +239,12 @@ func resourceScalewayRdbReadReplicaUpdate(ctx context.Context, d *schema.Resourc
 				EndpointID: expandID(d.Get("private_network.0.endpoint_id")),
 			}, scw.WithContext(ctx))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 		} else {
 			pnEndpoint, err := expandReadReplicaEndpointsSpecPrivateNetwork(d.Get("private_network"))
 			if err != nil {
-				return diag.FromErr(err)
+				return diagFromErr(err)
 			}
 			newEndpoints = append(newEndpoints, pnEndpoint)
 		}
@@ -253,7 +253,7 @@ func resourceScalewayRdbReadReplicaUpdate(ctx context.Context, d *schema.Resourc
 	if len(newEndpoints) > 0 {
 		_, err := waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		_, err = rdbAPI.CreateReadReplicaEndpoint(&rdb.CreateReadReplicaEndpointRequest{
 			Region:        region,
@@ -261,13 +261,13 @@ func resourceScalewayRdbReadReplicaUpdate(ctx context.Context, d *schema.Resourc
 			EndpointSpec:  newEndpoints,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayRdbReadReplicaRead(ctx, d, meta)
@@ -276,13 +276,13 @@ func resourceScalewayRdbReadReplicaUpdate(ctx context.Context, d *schema.Resourc
 func resourceScalewayRdbReadReplicaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rdbAPI, region, ID, err := rdbAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// We first wait in case the instance is in a transient state
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = rdbAPI.DeleteReadReplica(&rdb.DeleteReadReplicaRequest{
@@ -291,13 +291,13 @@ func resourceScalewayRdbReadReplicaDelete(ctx context.Context, d *schema.Resourc
 	}, scw.WithContext(ctx))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// Lastly wait in case the instance is in a transient state
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -45,10 +45,24 @@ func resourceScalewayRdbUser() *schema.Resource {
 				ForceNew:    true,
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "Database user password",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "generate_password"},
+				Description:  "Database user password",
+			},
+			"generate_password": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ExactlyOneOf: []string{"password", "generate_password"},
+				Description:  "Generate a random password for the database user",
+			},
+			"password_secret_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validationUUIDWithLocality(),
+				Description:  "ID of a secret in which a new version is stored with the database user password",
 			},
 			"is_admin": {
 				Type:        schema.TypeBool,
@@ -68,19 +82,30 @@ func resourceScalewayRdbUserCreate(ctx context.Context, d *schema.ResourceData,
 	regionalID := d.Get("instance_id").(string)
 	region, instanceID, err := parseRegionalID(regionalID)
 	if err != nil {
-		diag.FromErr(err)
+		diagFromErr(err)
 	}
 
 	ins, err := waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+
+	password := d.Get("password").(string)
+	if d.Get("generate_password").(bool) {
+		password, err = generateRdbUserPassword()
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+	if password == "" {
+		return diag.Errorf("one of password or generate_password must be set")
 	}
 
 	createReq := &rdb.CreateUserRequest{
 		Region:     region,
 		InstanceID: ins.ID,
 		Name:       d.Get("name").(string),
-		Password:   d.Get("password").(string),
+		Password:   password,
 		IsAdmin:    d.Get("is_admin").(bool),
 	}
 
@@ -103,10 +128,18 @@ func resourceScalewayRdbUserCreate(ctx context.Context, d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(resourceScalewayRdbUserID(region, expandID(instanceID), user.Name))
+	_ = d.Set("password", password)
+
+	if secretID, ok := d.GetOk("password_secret_id"); ok {
+		err = storeRdbUserPasswordInSecret(ctx, meta, secretID.(string), user.Name, password)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
 
 	return resourceScalewayRdbUserRead(ctx, d, meta)
 }
@@ -115,7 +148,7 @@ func resourceScalewayRdbUserRead(ctx context.Context, d *schema.ResourceData, me
 	rdbAPI := newRdbAPI(meta)
 	region, instanceID, userName, err := resourceScalewayRdbUserParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutRead))
@@ -124,20 +157,20 @@ func resourceScalewayRdbUserRead(ctx context.Context, d *schema.ResourceData, me
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := rdbAPI.ListUsers(&rdb.ListUsersRequest{
 		Region:     region,
 		InstanceID: instanceID,
 		Name:       &userName,
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx), scw.WithAllPages())
 	if err != nil {
 		if is404Error(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if len(res.Users) == 0 {
 		tflog.Warn(ctx, fmt.Sprintf("couldn'd find user with name: [%s]", userName))
@@ -161,12 +194,12 @@ func resourceScalewayRdbUserUpdate(ctx context.Context, d *schema.ResourceData,
 	// resource depends on the instance locality
 	region, instanceID, userName, err := resourceScalewayRdbUserParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &rdb.UpdateUserRequest{
@@ -175,8 +208,15 @@ func resourceScalewayRdbUserUpdate(ctx context.Context, d *schema.ResourceData,
 		Name:       userName,
 	}
 
-	if d.HasChange("password") {
-		req.Password = expandStringPtr(d.Get("password"))
+	password := d.Get("password").(string)
+	if d.HasChange("generate_password") && d.Get("generate_password").(bool) {
+		password, err = generateRdbUserPassword()
+		if err != nil {
+			return diagFromErr(err)
+		}
+		req.Password = &password
+	} else if d.HasChange("password") {
+		req.Password = expandStringPtr(password)
 	}
 	if d.HasChange("is_admin") {
 		req.IsAdmin = scw.BoolPtr(d.Get("is_admin").(bool))
@@ -184,7 +224,15 @@ func resourceScalewayRdbUserUpdate(ctx context.Context, d *schema.ResourceData,
 
 	_, err = rdbAPI.UpdateUser(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
+	}
+	_ = d.Set("password", password)
+
+	if secretID, ok := d.GetOk("password_secret_id"); ok && (req.Password != nil || d.HasChange("password_secret_id")) {
+		err = storeRdbUserPasswordInSecret(ctx, meta, secretID.(string), userName, password)
+		if err != nil {
+			return diagFromErr(err)
+		}
 	}
 
 	return resourceScalewayRdbUserRead(ctx, d, meta)
@@ -195,12 +243,12 @@ func resourceScalewayRdbUserDelete(ctx context.Context, d *schema.ResourceData,
 	// resource depends on the instance locality
 	region, instanceID, userName, err := resourceScalewayRdbUserParseID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
@@ -224,7 +272,7 @@ func resourceScalewayRdbUserDelete(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccScalewayRdbUser_Basic(t *testing.T) {
@@ -104,3 +105,25 @@ func testAccCheckRdbUserExists(tt *TestTools, instance string, user string) reso
 		return nil
 	}
 }
+
+func TestResourceScalewayRdbUserPasswordValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      map[string]interface{}
+		expectError bool
+	}{
+		{name: "password", config: map[string]interface{}{"password": "thiZ_is_v&ry_s3cret"}},
+		{name: "generated password", config: map[string]interface{}{"generate_password": true}},
+		{name: "no password", config: map[string]interface{}{}, expectError: true},
+		{name: "both passwords", config: map[string]interface{}{"password": "thiZ_is_v&ry_s3cret", "generate_password": true}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config["instance_id"] = "fr-par/11111111-1111-1111-1111-111111111111"
+			tt.config["name"] = "foo"
+			diags := resourceScalewayRdbUser().Validate(terraform.NewResourceConfigRaw(tt.config))
+			assert.Equal(t, tt.expectError, diags.HasError())
+		})
+	}
+}
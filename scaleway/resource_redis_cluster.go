@@ -27,7 +27,7 @@ func resourceScalewayRedisCluster() *schema.Resource {
 			Default: schema.DefaultTimeout(defaultRedisClusterTimeout),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findRedisClusterIDByName),
 		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -210,7 +210,7 @@ func resourceScalewayRedisCluster() *schema.Resource {
 func resourceScalewayRedisClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	redisAPI, zone, err := redisAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	createReq := &redis.CreateClusterRequest{
@@ -239,7 +239,7 @@ func resourceScalewayRedisClusterCreate(ctx context.Context, d *schema.ResourceD
 	if aclRulesExist {
 		rules, err := expandRedisACLSpecs(aclRules)
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		createReq.ACLRules = rules
 	}
@@ -252,21 +252,21 @@ func resourceScalewayRedisClusterCreate(ctx context.Context, d *schema.ResourceD
 	if pnExists {
 		pnSpecs, err := expandRedisPrivateNetwork(pn.(*schema.Set).List())
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		createReq.Endpoints = pnSpecs
 	}
 
 	res, err := redisAPI.CreateCluster(createReq, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, res.ID))
 
 	_, err = waitForRedisCluster(ctx, redisAPI, zone, res.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayRedisClusterRead(ctx, d, meta)
@@ -275,7 +275,7 @@ func resourceScalewayRedisClusterCreate(ctx context.Context, d *schema.ResourceD
 func resourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	redisAPI, zone, ID, err := redisAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	getReq := &redis.GetClusterRequest{
@@ -288,7 +288,7 @@ func resourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceDat
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", cluster.Name)
@@ -321,12 +321,12 @@ func resourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceDat
 			ClusterID: cluster.ID,
 		})
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to fetch cluster certificate: %w", err))
+			return diagFromErr(fmt.Errorf("failed to fetch cluster certificate: %w", err))
 		}
 
 		certificateContent, err := ioutil.ReadAll(certificate.Content)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("failed to read cluster certificate: %w", err))
+			return diagFromErr(fmt.Errorf("failed to read cluster certificate: %w", err))
 		}
 
 		_ = d.Set("certificate", string(certificateContent))
@@ -340,7 +340,7 @@ func resourceScalewayRedisClusterRead(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayRedisClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	redisAPI, zone, ID, err := redisAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &redis.UpdateClusterRequest{
@@ -375,12 +375,12 @@ func resourceScalewayRedisClusterUpdate(ctx context.Context, d *schema.ResourceD
 
 	_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = redisAPI.UpdateCluster(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	migrateClusterRequests := []redis.MigrateClusterRequest(nil)
@@ -408,16 +408,16 @@ func resourceScalewayRedisClusterUpdate(ctx context.Context, d *schema.ResourceD
 	for i := range migrateClusterRequests {
 		_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		_, err = redisAPI.MigrateCluster(&migrateClusterRequests[i], scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -430,7 +430,7 @@ func resourceScalewayRedisClusterUpdate(ctx context.Context, d *schema.ResourceD
 
 	_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayRedisClusterRead(ctx, d, meta)
@@ -439,7 +439,7 @@ func resourceScalewayRedisClusterUpdate(ctx context.Context, d *schema.ResourceD
 func resourceScalewayRedisClusterUpdateACL(ctx context.Context, d *schema.ResourceData, redisAPI *redis.API, zone scw.Zone, clusterID string) diag.Diagnostics {
 	rules, err := expandRedisACLSpecs(d.Get("acl"))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = redisAPI.SetACLRules(&redis.SetACLRulesRequest{
@@ -448,7 +448,7 @@ func resourceScalewayRedisClusterUpdateACL(ctx context.Context, d *schema.Resour
 		ACLRules:  rules,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -463,7 +463,7 @@ func resourceScalewayRedisClusterUpdateSettings(ctx context.Context, d *schema.R
 		Settings:  settings,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -473,14 +473,14 @@ func resourceScalewayRedisClusterUpdateEndpoints(ctx context.Context, d *schema.
 	// retrieve state
 	cluster, err := waitForRedisCluster(ctx, redisAPI, zone, clusterID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	// get new desired state of endpoints
 	rawNewEndpoints := d.Get("private_network")
 	newEndpoints, err := expandRedisPrivateNetwork(rawNewEndpoints.(*schema.Set).List())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 	if len(newEndpoints) == 0 {
 		newEndpoints = append(newEndpoints, &redis.EndpointSpec{
@@ -494,12 +494,12 @@ func resourceScalewayRedisClusterUpdateEndpoints(ctx context.Context, d *schema.
 		Endpoints: newEndpoints,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRedisCluster(ctx, redisAPI, zone, clusterID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -508,12 +508,12 @@ func resourceScalewayRedisClusterUpdateEndpoints(ctx context.Context, d *schema.
 func resourceScalewayRedisClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	redisAPI, zone, ID, err := redisAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = redisAPI.DeleteCluster(&redis.DeleteClusterRequest{
@@ -522,12 +522,12 @@ func resourceScalewayRedisClusterDelete(ctx context.Context, d *schema.ResourceD
 	}, scw.WithContext(ctx))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -16,7 +16,7 @@ func resourceScalewayRegistryNamespace() *schema.Resource {
 		UpdateContext: resourceScalewayRegistryNamespaceUpdate,
 		DeleteContext: resourceScalewayRegistryNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findRegistryNamespaceIDByName),
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultRedisClusterTimeout),
@@ -48,6 +48,16 @@ func resourceScalewayRegistryNamespace() *schema.Resource {
 				Computed:    true,
 				Description: "The endpoint reachable by docker",
 			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total size in bytes of the images of the namespace",
+			},
+			"image_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of images in the namespace",
+			},
 			"region":          regionSchema(),
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
@@ -58,7 +68,7 @@ func resourceScalewayRegistryNamespace() *schema.Resource {
 func resourceScalewayRegistryNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := registryAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ns, err := api.CreateNamespace(&registry.CreateNamespaceRequest{
@@ -69,14 +79,14 @@ func resourceScalewayRegistryNamespaceCreate(ctx context.Context, d *schema.Reso
 		IsPublic:    d.Get("is_public").(bool),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, ns.ID))
 
 	_, err = waitForRegistryNamespace(ctx, api, region, ns.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayRegistryNamespaceRead(ctx, d, meta)
@@ -85,7 +95,7 @@ func resourceScalewayRegistryNamespaceCreate(ctx context.Context, d *schema.Reso
 func resourceScalewayRegistryNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := registryAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ns, err := waitForRegistryNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -94,7 +104,7 @@ func resourceScalewayRegistryNamespaceRead(ctx context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", ns.Name)
@@ -103,6 +113,8 @@ func resourceScalewayRegistryNamespaceRead(ctx context.Context, d *schema.Resour
 	_ = d.Set("project_id", ns.ProjectID)
 	_ = d.Set("is_public", ns.IsPublic)
 	_ = d.Set("endpoint", ns.Endpoint)
+	_ = d.Set("size", int(ns.Size))
+	_ = d.Set("image_count", int(ns.ImageCount))
 	_ = d.Set("region", ns.Region)
 
 	return nil
@@ -111,7 +123,7 @@ func resourceScalewayRegistryNamespaceRead(ctx context.Context, d *schema.Resour
 func resourceScalewayRegistryNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := registryAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRegistryNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutUpdate))
@@ -120,7 +132,7 @@ func resourceScalewayRegistryNamespaceUpdate(ctx context.Context, d *schema.Reso
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChanges("description", "is_public") {
@@ -130,7 +142,7 @@ func resourceScalewayRegistryNamespaceUpdate(ctx context.Context, d *schema.Reso
 			Description: expandUpdatedStringPtr(d.Get("description")),
 			IsPublic:    scw.BoolPtr(d.Get("is_public").(bool)),
 		}, scw.WithContext(ctx)); err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -140,7 +152,7 @@ func resourceScalewayRegistryNamespaceUpdate(ctx context.Context, d *schema.Reso
 func resourceScalewayRegistryNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := registryAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRegistryNamespace(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -149,7 +161,7 @@ func resourceScalewayRegistryNamespaceDelete(ctx context.Context, d *schema.Reso
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DeleteNamespace(&registry.DeleteNamespaceRequest{
@@ -157,12 +169,12 @@ func resourceScalewayRegistryNamespaceDelete(ctx context.Context, d *schema.Reso
 		NamespaceID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForRegistryNamespaceDelete(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
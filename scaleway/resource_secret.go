@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -61,6 +62,23 @@ func resourceScalewaySecret() *schema.Resource {
 				Computed:    true,
 				Description: "Date and time of secret's creation (RFC 3339 format)",
 			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     secret.SecretTypeOpaque.String(),
+				Description: "Type of the secret. Versions of a certificate secret must contain a PEM certificate chain and its private key",
+				ValidateFunc: validation.StringInSlice([]string{
+					secret.SecretTypeOpaque.String(),
+					secret.SecretTypeCertificate.String(),
+				}, false),
+			},
+			"protected": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "True if secret protection is enabled on a given secret. A protected secret cannot be deleted.",
+			},
 			"region":     regionSchema(),
 			"project_id": projectIDSchema(),
 		},
@@ -70,13 +88,14 @@ func resourceScalewaySecret() *schema.Resource {
 func resourceScalewaySecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := secretAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	secretCreateRequest := &secret.CreateSecretRequest{
 		Region:    region,
 		ProjectID: d.Get("project_id").(string),
 		Name:      d.Get("name").(string),
+		Type:      secret.SecretType(d.Get("type").(string)),
 	}
 
 	rawTag, tagExist := d.GetOk("tags")
@@ -91,18 +110,25 @@ func resourceScalewaySecretCreate(ctx context.Context, d *schema.ResourceData, m
 
 	secretResponse, err := api.CreateSecret(secretCreateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, secretResponse.ID))
 
+	if d.Get("protected").(bool) {
+		err = updateSecretProtection(ctx, api, region, secretResponse.ID, true)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
 	return resourceScalewaySecretRead(ctx, d, meta)
 }
 
 func resourceScalewaySecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := secretAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	secretResponse, err := api.GetSecret(&secret.GetSecretRequest{
@@ -114,7 +140,7 @@ func resourceScalewaySecretRead(ctx context.Context, d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if len(secretResponse.Tags) > 0 {
@@ -127,6 +153,8 @@ func resourceScalewaySecretRead(ctx context.Context, d *schema.ResourceData, met
 	_ = d.Set("updated_at", flattenTime(secretResponse.UpdatedAt))
 	_ = d.Set("status", secretResponse.Status.String())
 	_ = d.Set("version_count", int(secretResponse.VersionCount))
+	_ = d.Set("protected", secretResponse.IsProtected)
+	_ = d.Set("type", secretResponse.Type.String())
 	_ = d.Set("region", string(region))
 	_ = d.Set("project_id", secretResponse.ProjectID)
 
@@ -136,7 +164,7 @@ func resourceScalewaySecretRead(ctx context.Context, d *schema.ResourceData, met
 func resourceScalewaySecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := secretAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	updateRequest := &secret.UpdateSecretRequest{
@@ -164,11 +192,14 @@ func resourceScalewaySecretUpdate(ctx context.Context, d *schema.ResourceData, m
 	if hasChanged {
 		_, err := api.UpdateSecret(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
+	}
 
+	if d.HasChange("protected") {
+		err = updateSecretProtection(ctx, api, region, id, d.Get("protected").(bool))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -178,7 +209,7 @@ func resourceScalewaySecretUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceScalewaySecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := secretAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = api.DeleteSecret(&secret.DeleteSecretRequest{
@@ -186,7 +217,7 @@ func resourceScalewaySecretDelete(ctx context.Context, d *schema.ResourceData, m
 		SecretID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
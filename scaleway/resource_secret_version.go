@@ -51,6 +51,12 @@ func resourceScalewaySecretVersion() *schema.Resource {
 				Computed:    true,
 				Description: "Status of the secret version",
 			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disable the secret version. A disabled version cannot be accessed but can be enabled again",
+			},
 			"revision": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -74,14 +80,11 @@ func resourceScalewaySecretVersion() *schema.Resource {
 func resourceScalewaySecretVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := secretAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	secretID := expandID(d.Get("secret_id").(string))
 	payloadSecretRaw := []byte(d.Get("data").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	secretCreateVersionRequest := &secret.CreateSecretVersionRequest{
 		Region:      region,
 		SecretID:    secretID,
@@ -91,20 +94,27 @@ func resourceScalewaySecretVersionCreate(ctx context.Context, d *schema.Resource
 
 	secretResponse, err := api.CreateSecretVersion(secretCreateVersionRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(secretVersionCreationError(ctx, api, region, secretID, payloadSecretRaw, err))
 	}
 
 	_ = d.Set("data", base64Encoded(payloadSecretRaw))
 
 	d.SetId(newRegionalIDString(region, fmt.Sprintf("%s/%d", secretResponse.SecretID, secretResponse.Revision)))
 
+	if d.Get("disabled").(bool) {
+		err = updateSecretVersionStatus(ctx, api, region, secretResponse.SecretID, strconv.Itoa(int(secretResponse.Revision)), true)
+		if err != nil {
+			return diagFromErr(err)
+		}
+	}
+
 	return resourceScalewaySecretVersionRead(ctx, d, meta)
 }
 
 func resourceScalewaySecretVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, revision, err := secretVersionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	secretResponse, err := api.GetSecretVersion(&secret.GetSecretVersionRequest{
@@ -117,7 +127,7 @@ func resourceScalewaySecretVersionRead(ctx context.Context, d *schema.ResourceDa
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	revisionStr := strconv.Itoa(int(secretResponse.Revision))
@@ -127,6 +137,7 @@ func resourceScalewaySecretVersionRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("created_at", flattenTime(secretResponse.CreatedAt))
 	_ = d.Set("updated_at", flattenTime(secretResponse.UpdatedAt))
 	_ = d.Set("status", secretResponse.Status.String())
+	_ = d.Set("disabled", secretResponse.Status == secret.SecretVersionStatusDisabled)
 	_ = d.Set("region", string(region))
 
 	return nil
@@ -135,7 +146,7 @@ func resourceScalewaySecretVersionRead(ctx context.Context, d *schema.ResourceDa
 func resourceScalewaySecretVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, revision, err := secretVersionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	updateRequest := &secret.UpdateSecretVersionRequest{
@@ -154,7 +165,14 @@ func resourceScalewaySecretVersionUpdate(ctx context.Context, d *schema.Resource
 	if hasChanged {
 		_, err := api.UpdateSecretVersion(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
+		}
+	}
+
+	if d.HasChange("disabled") {
+		err = updateSecretVersionStatus(ctx, api, region, id, revision, d.Get("disabled").(bool))
+		if err != nil {
+			return diagFromErr(err)
 		}
 	}
 
@@ -164,7 +182,7 @@ func resourceScalewaySecretVersionUpdate(ctx context.Context, d *schema.Resource
 func resourceScalewaySecretVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, revision, err := secretVersionAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.DestroySecretVersion(&secret.DestroySecretVersionRequest{
@@ -173,7 +191,7 @@ func resourceScalewaySecretVersionDelete(ctx context.Context, d *schema.Resource
 		Revision: revision,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -85,6 +85,11 @@ func resourceScalewayTemDomain() *schema.Resource {
 				Computed:    true,
 				Description: "DKIM public key, as should be recorded in the DNS zone",
 			},
+			"dkim_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "DKIM name for the DNS TXT record",
+			},
 			"smtp_host": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -124,7 +129,7 @@ func resourceScalewayTemDomain() *schema.Resource {
 func resourceScalewayTemDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := temAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	domain, err := api.CreateDomain(&tem.CreateDomainRequest{
@@ -134,7 +139,7 @@ func resourceScalewayTemDomainCreate(ctx context.Context, d *schema.ResourceData
 		AcceptTos:  d.Get("accept_tos").(bool),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, domain.ID))
@@ -145,7 +150,7 @@ func resourceScalewayTemDomainCreate(ctx context.Context, d *schema.ResourceData
 func resourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := temAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	domain, err := api.GetDomain(&tem.GetDomainRequest{
@@ -157,7 +162,7 @@ func resourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", domain.Name)
@@ -170,6 +175,7 @@ func resourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("last_error", domain.LastError)
 	_ = d.Set("spf_config", domain.SpfConfig)
 	_ = d.Set("dkim_config", domain.DkimConfig)
+	_ = d.Set("dkim_name", temDomainDKIMName(domain.ProjectID))
 	_ = d.Set("smtp_host", tem.SMTPHost)
 	_ = d.Set("smtp_port_unsecure", tem.SMTPPortUnsecure)
 	_ = d.Set("smtp_port", tem.SMTPPort)
@@ -185,7 +191,7 @@ func resourceScalewayTemDomainRead(ctx context.Context, d *schema.ResourceData,
 func resourceScalewayTemDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := temAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForTemDomain(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -195,7 +201,7 @@ func resourceScalewayTemDomainDelete(ctx context.Context, d *schema.ResourceData
 			return nil
 		}
 
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = api.RevokeDomain(&tem.RevokeDomainRequest{
@@ -203,12 +209,12 @@ func resourceScalewayTemDomainDelete(ctx context.Context, d *schema.ResourceData
 		DomainID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForTemDomain(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -0,0 +1,115 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tem "github.com/scaleway/scaleway-sdk-go/api/tem/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayTemDomainValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayTemDomainValidationCreate,
+		ReadContext:   resourceScalewayTemDomainValidationRead,
+		DeleteContext: resourceScalewayTemDomainValidationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultTemDomainValidationTimeout),
+			Default: schema.DefaultTimeout(defaultTemDomainValidationTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The id of domain name used when sending emails.",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"validated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the domain is verified for email sending",
+			},
+			"region": regionSchema(),
+		},
+		CustomizeDiff: customizeDiffLocalityCheck("domain_id"),
+	}
+}
+
+func resourceScalewayTemDomainValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, region, err := temAPIWithRegion(d, meta)
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	domainID := expandID(d.Get("domain_id"))
+
+	// DNS propagation may take a while, keep checking the domain until it is validated
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		domain, errGet := waitForTemDomain(ctx, api, region, domainID, d.Timeout(schema.TimeoutCreate))
+		if errGet != nil {
+			return resource.NonRetryableError(errGet)
+		}
+
+		switch domain.Status {
+		case tem.DomainStatusChecked:
+			return nil
+		case tem.DomainStatusRevoked:
+			return resource.NonRetryableError(fmt.Errorf("domain %s has been revoked", domain.Name))
+		}
+
+		// The domain is otherwise only checked periodically by the API
+		_, errCheck := api.CheckDomain(&tem.CheckDomainRequest{
+			Region:   region,
+			DomainID: domainID,
+		}, scw.WithContext(ctx))
+		if errCheck != nil {
+			return resource.NonRetryableError(errCheck)
+		}
+
+		return resource.RetryableError(fmt.Errorf("domain %s is not validated yet (status: %s)", domain.Name, domain.Status))
+	})
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	d.SetId(newRegionalIDString(region, domainID))
+
+	return resourceScalewayTemDomainValidationRead(ctx, d, meta)
+}
+
+func resourceScalewayTemDomainValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, region, id, err := temAPIWithRegionAndID(meta, d.Id())
+	if err != nil {
+		return diagFromErr(err)
+	}
+
+	domain, err := api.GetDomain(&tem.GetDomainRequest{
+		Region:   region,
+		DomainID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if is404Error(err) {
+			d.SetId("")
+			return nil
+		}
+		return diagFromErr(err)
+	}
+
+	_ = d.Set("domain_id", newRegionalIDString(region, domain.ID))
+	_ = d.Set("validated", domain.Status == tem.DomainStatusChecked)
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func resourceScalewayTemDomainValidationDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Validation has no remote counterpart, the domain itself is managed by scaleway_tem_domain
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayTemDomainValidation_Basic(t *testing.T) {
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	domainName := "tem-validation." + testDomain
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayTemDomainDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_tem_domain" "main" {
+						name       = "%[1]s"
+						accept_tos = true
+					}
+
+					resource "scaleway_domain_record" "spf" {
+						dns_zone = "%[2]s"
+						name     = "tem-validation"
+						type     = "TXT"
+						data     = "v=spf1 ${scaleway_tem_domain.main.spf_config} -all"
+					}
+
+					resource "scaleway_domain_record" "dkim" {
+						dns_zone = "%[2]s"
+						name     = "${scaleway_tem_domain.main.dkim_name}.tem-validation"
+						type     = "TXT"
+						data     = scaleway_tem_domain.main.dkim_config
+					}
+
+					resource "scaleway_tem_domain_validation" "main" {
+						domain_id = scaleway_tem_domain.main.id
+
+						depends_on = [
+							scaleway_domain_record.spf,
+							scaleway_domain_record.dkim,
+						]
+					}
+				`, domainName, testDomain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayTemDomainExists(tt, "scaleway_tem_domain.main"),
+					resource.TestCheckResourceAttrPair("scaleway_tem_domain_validation.main", "domain_id", "scaleway_tem_domain.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_tem_domain_validation.main", "validated", "true"),
+				),
+			},
+		},
+	})
+}
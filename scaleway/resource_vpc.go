@@ -16,7 +16,7 @@ func resourceScalewayVPC() *schema.Resource {
 		UpdateContext: resourceScalewayVPCUpdate,
 		DeleteContext: resourceScalewayVPCDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findVPCIDByName),
 		},
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
@@ -34,6 +34,7 @@ func resourceScalewayVPC() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"tags_all":   tagsAllSchema(),
 			"project_id": projectIDSchema(),
 			"region":     regionSchema(),
 			// Computed elements
@@ -54,23 +55,24 @@ func resourceScalewayVPC() *schema.Resource {
 				Description: "The date and time of the last update of the private network",
 			},
 		},
+		CustomizeDiff: customizeDiffDefaultTags,
 	}
 }
 
 func resourceScalewayVPCCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := vpcAPI.CreateVPC(&vpc.CreateVPCRequest{
 		Name:      expandOrGenerateString(d.Get("name"), "vpc"),
-		Tags:      expandStrings(d.Get("tags")),
+		Tags:      expandResourceTags(d, meta),
 		ProjectID: d.Get("project_id").(string),
 		Region:    region,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, res.ID))
@@ -81,7 +83,7 @@ func resourceScalewayVPCCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceScalewayVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := vpcAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := vpcAPI.GetVPC(&vpc.GetVPCRequest{
@@ -93,7 +95,7 @@ func resourceScalewayVPCRead(ctx context.Context, d *schema.ResourceData, meta i
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", res.Name)
@@ -104,9 +106,10 @@ func resourceScalewayVPCRead(ctx context.Context, d *schema.ResourceData, meta i
 	_ = d.Set("is_default", res.IsDefault)
 	_ = d.Set("region", region)
 
-	if len(res.Tags) > 0 {
-		_ = d.Set("tags", res.Tags)
+	if tags := flattenResourceTags(d, meta, res.Tags); len(tags) > 0 {
+		_ = d.Set("tags", tags)
 	}
+	_ = d.Set("tags_all", res.Tags)
 
 	return nil
 }
@@ -114,17 +117,17 @@ func resourceScalewayVPCRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceScalewayVPCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := vpcAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = vpcAPI.UpdateVPC(&vpc.UpdateVPCRequest{
 		VpcID:  ID,
 		Region: region,
 		Name:   scw.StringPtr(d.Get("name").(string)),
-		Tags:   expandUpdatedStringsPtr(d.Get("tags")),
+		Tags:   expandUpdatedResourceTags(d, meta),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayVPCRead(ctx, d, meta)
@@ -133,7 +136,7 @@ func resourceScalewayVPCUpdate(ctx context.Context, d *schema.ResourceData, meta
 func resourceScalewayVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := vpcAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = vpcAPI.DeleteVPC(&vpc.DeleteVPCRequest{
@@ -141,7 +144,7 @@ func resourceScalewayVPCDelete(ctx context.Context, d *schema.ResourceData, meta
 		VpcID:  ID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -17,7 +17,7 @@ func resourceScalewayVPCPrivateNetwork() *schema.Resource {
 		UpdateContext: resourceScalewayVPCPrivateNetworkUpdate,
 		DeleteContext: resourceScalewayVPCPrivateNetworkDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithName(findVPCPrivateNetworkIDByName),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -137,6 +137,7 @@ func resourceScalewayVPCPrivateNetwork() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"tags_all": tagsAllSchema(),
 			"is_regional": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -157,7 +158,7 @@ func resourceScalewayVPCPrivateNetwork() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				Deprecated:       "This field is deprecated and will be removed in the next major version, please use `region` instead",
-				ValidateDiagFunc: validateStringInSliceWithWarning(allZones(), "zone"),
+				ValidateDiagFunc: validateStringInCatalog(allZones(), zoneFormat, "zone"),
 			},
 			"region": regionSchema(),
 			// Computed elements
@@ -173,23 +174,24 @@ func resourceScalewayVPCPrivateNetwork() *schema.Resource {
 				Description: "The date and time of the last update of the private network",
 			},
 		},
+		CustomizeDiff: customizeDiffDefaultTags,
 	}
 }
 
 func resourceScalewayVPCPrivateNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, err := vpcAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ipv4Subnets, ipv6Subnets, err := expandSubnets(d)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	req := &vpc.CreatePrivateNetworkRequest{
 		Name:      expandOrGenerateString(d.Get("name"), "pn"),
-		Tags:      expandStrings(d.Get("tags")),
+		Tags:      expandResourceTags(d, meta),
 		ProjectID: d.Get("project_id").(string),
 		Region:    region,
 	}
@@ -209,7 +211,7 @@ func resourceScalewayVPCPrivateNetworkCreate(ctx context.Context, d *schema.Reso
 
 	pn, err := vpcAPI.CreatePrivateNetwork(req, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, pn.ID))
@@ -220,7 +222,7 @@ func resourceScalewayVPCPrivateNetworkCreate(ctx context.Context, d *schema.Reso
 func resourceScalewayVPCPrivateNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := vpcAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	pn, err := vpcAPI.GetPrivateNetwork(&vpc.GetPrivateNetworkRequest{
@@ -232,12 +234,12 @@ func resourceScalewayVPCPrivateNetworkRead(ctx context.Context, d *schema.Resour
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	zone, err := extractZone(d, meta.(*Meta))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("name", pn.Name)
@@ -246,7 +248,8 @@ func resourceScalewayVPCPrivateNetworkRead(ctx context.Context, d *schema.Resour
 	_ = d.Set("project_id", pn.ProjectID)
 	_ = d.Set("created_at", flattenTime(pn.CreatedAt))
 	_ = d.Set("updated_at", flattenTime(pn.UpdatedAt))
-	_ = d.Set("tags", pn.Tags)
+	_ = d.Set("tags", flattenResourceTags(d, meta, pn.Tags))
+	_ = d.Set("tags_all", pn.Tags)
 	_ = d.Set("region", region)
 	_ = d.Set("is_regional", true)
 	_ = d.Set("zone", zone)
@@ -261,17 +264,17 @@ func resourceScalewayVPCPrivateNetworkRead(ctx context.Context, d *schema.Resour
 func resourceScalewayVPCPrivateNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := vpcAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = vpcAPI.UpdatePrivateNetwork(&vpc.UpdatePrivateNetworkRequest{
 		PrivateNetworkID: ID,
 		Region:           region,
 		Name:             scw.StringPtr(d.Get("name").(string)),
-		Tags:             expandUpdatedStringsPtr(d.Get("tags")),
+		Tags:             expandUpdatedResourceTags(d, meta),
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayVPCPrivateNetworkRead(ctx, d, meta)
@@ -280,7 +283,7 @@ func resourceScalewayVPCPrivateNetworkUpdate(ctx context.Context, d *schema.Reso
 func resourceScalewayVPCPrivateNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcAPI, region, ID, err := vpcAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	var warnings diag.Diagnostics
@@ -295,7 +298,7 @@ func resourceScalewayVPCPrivateNetworkDelete(ctx context.Context, d *schema.Reso
 				Summary:  err.Error(),
 			})
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
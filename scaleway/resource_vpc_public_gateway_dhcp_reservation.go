@@ -20,7 +20,7 @@ func resourceScalewayVPCPublicGatewayDHCPReservation() *schema.Resource {
 		UpdateContext: resourceScalewayVPCPublicGatewayDHCPReservationUpdate,
 		DeleteContext: resourceScalewayVPCPublicGatewayDHCPReservationDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importVPCPublicGatewayDHCPReservation,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultVPCGatewayTimeout),
@@ -77,23 +77,23 @@ func resourceScalewayVPCPublicGatewayDHCPReservation() *schema.Resource {
 func resourceScalewayVPCPublicGatewayDHCPCReservationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, err := vpcgwAPIWithZone(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	ip := net.ParseIP(d.Get("ip_address").(string))
 	if ip == nil {
-		return diag.FromErr(fmt.Errorf("could not parse ip_address"))
+		return diagFromErr(fmt.Errorf("could not parse ip_address"))
 	}
 
 	macAddress, err := net.ParseMAC(d.Get("mac_address").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	gatewayNetworkID := expandID(d.Get("gateway_network_id"))
 	_, err = waitForVPCGatewayNetwork(ctx, vpcgwAPI, zone, gatewayNetworkID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := vpcgwAPI.CreateDHCPEntry(&vpcgw.CreateDHCPEntryRequest{
@@ -103,14 +103,14 @@ func resourceScalewayVPCPublicGatewayDHCPCReservationCreate(ctx context.Context,
 		GatewayNetworkID: gatewayNetworkID,
 	}, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newZonedIDString(zone, res.ID))
 
 	_, err = waitForVPCGatewayNetwork(ctx, vpcgwAPI, zone, gatewayNetworkID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayVPCPublicGatewayDHCPReservationRead(ctx, d, meta)
@@ -119,7 +119,7 @@ func resourceScalewayVPCPublicGatewayDHCPCReservationCreate(ctx context.Context,
 func resourceScalewayVPCPublicGatewayDHCPReservationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, ID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	entry, err := vpcgwAPI.GetDHCPEntry(&vpcgw.GetDHCPEntryRequest{
@@ -131,7 +131,7 @@ func resourceScalewayVPCPublicGatewayDHCPReservationRead(ctx context.Context, d
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_ = d.Set("ip_address", entry.IPAddress.String())
@@ -149,19 +149,19 @@ func resourceScalewayVPCPublicGatewayDHCPReservationRead(ctx context.Context, d
 func resourceScalewayVPCPublicGatewayDHCPReservationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, ID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if d.HasChanges("ip_address") {
 		ip := net.ParseIP(d.Get("ip_address").(string))
 		if ip == nil {
-			return diag.FromErr(fmt.Errorf("could not parse ip_address"))
+			return diagFromErr(fmt.Errorf("could not parse ip_address"))
 		}
 
 		gatewayNetworkID := expandID(d.Get("gateway_network_id"))
 		_, err = waitForVPCGatewayNetwork(ctx, vpcgwAPI, zone, gatewayNetworkID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		req := &vpcgw.UpdateDHCPEntryRequest{
@@ -172,12 +172,12 @@ func resourceScalewayVPCPublicGatewayDHCPReservationUpdate(ctx context.Context,
 
 		_, err = vpcgwAPI.UpdateDHCPEntry(req, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 
 		_, err = waitForVPCGatewayNetwork(ctx, vpcgwAPI, zone, gatewayNetworkID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -187,13 +187,13 @@ func resourceScalewayVPCPublicGatewayDHCPReservationUpdate(ctx context.Context,
 func resourceScalewayVPCPublicGatewayDHCPReservationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	vpcgwAPI, zone, ID, err := vpcgwAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	gatewayNetworkID := expandID(d.Get("gateway_network_id"))
 	_, err = waitForVPCGatewayNetwork(ctx, vpcgwAPI, zone, gatewayNetworkID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	err = vpcgwAPI.DeleteDHCPEntry(&vpcgw.DeleteDHCPEntryRequest{
@@ -202,12 +202,12 @@ func resourceScalewayVPCPublicGatewayDHCPReservationDelete(ctx context.Context,
 	}, scw.WithContext(ctx))
 
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForVPCGatewayNetwork(ctx, vpcgwAPI, zone, gatewayNetworkID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return nil
@@ -134,6 +134,69 @@ func resourceScalewayWebhosting() *schema.Resource {
 				Computed:    true,
 				Description: "Main hosting cPanel username",
 			},
+			"name_servers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of nameservers associated with the hosting domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Hostname of the nameserver",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the nameserver",
+						},
+						"is_default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the nameserver is the default one",
+						},
+					},
+				},
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of DNS records associated with the hosting domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the DNS record",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the DNS record",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Time to live of the DNS record",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the DNS record",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Priority of the DNS record",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the DNS record",
+						},
+					},
+				},
+			},
 			"region":          regionSchema(),
 			"project_id":      projectIDSchema(),
 			"organization_id": organizationIDSchema(),
@@ -159,12 +222,12 @@ func resourceScalewayWebhosting() *schema.Resource {
 func resourceScalewayWebhostingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, err := webhostingAPIWithRegion(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, offerID, err := parseRegionalID(d.Get("offer_id").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	hostingCreateRequest := &webhosting.CreateHostingRequest{
@@ -192,14 +255,14 @@ func resourceScalewayWebhostingCreate(ctx context.Context, d *schema.ResourceDat
 
 	hostingResponse, err := api.CreateHosting(hostingCreateRequest, scw.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	d.SetId(newRegionalIDString(region, hostingResponse.ID))
 
 	_, err = waitForHosting(ctx, api, region, hostingResponse.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	return resourceScalewayWebhostingRead(ctx, d, meta)
@@ -208,7 +271,7 @@ func resourceScalewayWebhostingCreate(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayWebhostingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := webhostingAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	webhostingResponse, err := waitForHosting(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
@@ -217,7 +280,7 @@ func resourceScalewayWebhostingRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	if len(webhostingResponse.Tags) > 0 {
@@ -240,18 +303,34 @@ func resourceScalewayWebhostingRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("organization_id", webhostingResponse.OrganizationID)
 	_ = d.Set("project_id", webhostingResponse.ProjectID)
 
+	dnsRecords, err := api.GetDomainDNSRecords(&webhosting.GetDomainDNSRecordsRequest{
+		Region: region,
+		Domain: webhostingResponse.Domain,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		// DNS records are informative, they must not prevent reading the hosting
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Failed to get hosting DNS records",
+			Detail:   err.Error(),
+		}}
+	}
+
+	_ = d.Set("name_servers", flattenHostingNameServers(dnsRecords.NameServers))
+	_ = d.Set("records", flattenHostingDNSRecords(dnsRecords.Records))
+
 	return nil
 }
 
 func resourceScalewayWebhostingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := webhostingAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	res, err := waitForHosting(ctx, api, region, id, d.Timeout(schema.TimeoutRead))
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	updateRequest := &webhosting.UpdateHostingRequest{
@@ -269,7 +348,7 @@ func resourceScalewayWebhostingUpdate(ctx context.Context, d *schema.ResourceDat
 	if d.HasChange("offer_id") {
 		_, offerID, err := parseRegionalID(d.Get("offer_id").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 		updateRequest.OfferID = expandUpdatedStringPtr(offerID)
 		hasChanged = true
@@ -288,7 +367,7 @@ func resourceScalewayWebhostingUpdate(ctx context.Context, d *schema.ResourceDat
 	if hasChanged {
 		_, err := api.UpdateHosting(updateRequest, scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diagFromErr(err)
 		}
 	}
 
@@ -298,7 +377,7 @@ func resourceScalewayWebhostingUpdate(ctx context.Context, d *schema.ResourceDat
 func resourceScalewayWebhostingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api, region, id, err := webhostingAPIWithRegionAndID(meta, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForHosting(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
@@ -311,7 +390,7 @@ func resourceScalewayWebhostingDelete(ctx context.Context, d *schema.ResourceDat
 		HostingID: id,
 	}, scw.WithContext(ctx))
 	if err != nil && !is404Error(err) {
-		return diag.FromErr(err)
+		return diagFromErr(err)
 	}
 
 	_, err = waitForHosting(ctx, api, region, id, d.Timeout(schema.TimeoutDelete))
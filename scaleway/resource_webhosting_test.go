@@ -77,6 +77,8 @@ func TestAccScalewayWebhosting_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr("scaleway_webhosting.main", "tags.2", "terraform"),
 					resource.TestCheckResourceAttrSet("scaleway_webhosting.main", "updated_at"),
 					resource.TestCheckResourceAttrSet("scaleway_webhosting.main", "created_at"),
+					resource.TestCheckResourceAttrSet("scaleway_webhosting.main", "name_servers.0.hostname"),
+					resource.TestCheckResourceAttrSet("scaleway_webhosting.main", "records.0.type"),
 					testCheckResourceAttrUUID("scaleway_webhosting.main", "id"),
 				),
 			},
@@ -5,12 +5,16 @@ import (
 	"context"
 	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 )
 
+const defaultMaxRetries = 3
+
 type retryableTransportOptions struct {
 	RetryMax     *int
 	RetryWaitMax *time.Duration
@@ -22,7 +26,7 @@ func newRetryableTransportWithOptions(defaultTransport http.RoundTripper, option
 	c.HTTPClient = &http.Client{Transport: defaultTransport}
 
 	// Defaults
-	c.RetryMax = 3
+	c.RetryMax = defaultMaxRetries
 	c.RetryWaitMax = 2 * time.Minute
 	c.Logger = l
 	c.RetryWaitMin = time.Second * 2
@@ -32,6 +36,7 @@ func newRetryableTransportWithOptions(defaultTransport http.RoundTripper, option
 		}
 		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
 	}
+	c.Backoff = retryableTransportBackoff
 
 	// If ErrorHandler is not set, retryablehttp will wrap http errors
 	c.ErrorHandler = func(resp *http.Response, err error, numTries int) (*http.Response, error) {
@@ -57,6 +62,49 @@ func newRetryableTransportWithOptions(defaultTransport http.RoundTripper, option
 	return &retryableTransport{c}
 }
 
+// retryableTransportBackoff honors the Retry-After header sent with 429 and 503 responses,
+// otherwise it uses an exponential backoff with jitter. The wait is always capped by max.
+func retryableTransportBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if retryAfter > max {
+				return max
+			}
+			return retryAfter
+		}
+	}
+
+	sleep := retryablehttp.DefaultBackoff(min, max, attemptNum, nil)
+	if sleep <= 0 {
+		return sleep
+	}
+
+	// Pick a random duration between sleep/2 and sleep to spread concurrent retries
+	half := sleep / 2
+	return half + time.Duration(rand.Int63n(int64(sleep-half)+1)) //nolint:gosec
+}
+
+// parseRetryAfter parses a Retry-After header value, either given in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
 // TODO Retry logic should be moved in the SDK
 // newRetryableTransport creates a http transport with retry capability.
 func newRetryableTransport(defaultTransport http.RoundTripper) http.RoundTripper {
@@ -92,5 +140,7 @@ func (c *retryableTransport) RoundTrip(r *http.Request) (*http.Response, error)
 		}
 		return io.NopCloser(bytes.NewReader(b)), err
 	}
-	return c.Client.Do(req)
+	resp, err := c.Client.Do(req)
+	addAPIErrorDetails(r, resp)
+	return resp, err
 }
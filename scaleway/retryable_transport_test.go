@@ -0,0 +1,43 @@
+package scaleway
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryableTransportBackoff(t *testing.T) {
+	tooManyRequests := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"5"}},
+	}
+	assert.Equal(t, 5*time.Second, retryableTransportBackoff(time.Second, time.Minute, 1, tooManyRequests))
+	assert.Equal(t, 2*time.Second, retryableTransportBackoff(time.Second, 2*time.Second, 1, tooManyRequests))
+	assert.Equal(t, time.Duration(0), retryableTransportBackoff(time.Second, 0, 1, tooManyRequests))
+
+	for attempt := 0; attempt < 5; attempt++ {
+		sleep := retryableTransportBackoff(time.Second, time.Minute, attempt, &http.Response{StatusCode: http.StatusBadGateway})
+		expected := time.Second << attempt
+		assert.GreaterOrEqual(t, sleep, expected/2)
+		assert.LessOrEqual(t, sleep, expected)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("12")
+	assert.True(t, ok)
+	assert.Equal(t, 12*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("-1")
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
@@ -2,10 +2,21 @@ package scaleway
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/scaleway/scaleway-sdk-go/validation"
 )
 
+var (
+	zoneFormat   = regexp.MustCompile(`(?i)^[a-z]{2}-[a-z]{3}-[0-9]+$`)
+	regionFormat = regexp.MustCompile(`(?i)^[a-z]{2}-[a-z]{3}$`)
+	// commercialTypeFormat matches commercial types such as "DEV1-S", "LB-GP-M" or "db-dev-s".
+	commercialTypeFormat = regexp.MustCompile(`(?i)^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
 // validationUUID validates the schema is a UUID or the combination of a locality and a UUID
 // e.g. "6ba7b810-9dad-11d1-80b4-00c04fd430c8" or "fr-par-1/6ba7b810-9dad-11d1-80b4-00c04fd430c8".
 func validationUUIDorUUIDWithLocality() func(interface{}, string) ([]string, []error) {
@@ -61,3 +72,113 @@ func validationEmail() func(interface{}, string) ([]string, []error) {
 		return
 	}
 }
+
+// validateStringInCatalog validates the value against a known catalog, like zones or regions.
+// Values that do not match the expected format or that are a typo of a catalog entry are rejected at plan time.
+// Other well-formed values missing from the catalog only raise a warning, as non-public localities may not be listed.
+func validateStringInCatalog(catalog []string, format *regexp.Regexp, field string) func(interface{}, cty.Path) diag.Diagnostics {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		value, isString := i.(string)
+		if !isString {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("expected type of %s to be string", field),
+				AttributePath: path,
+			}}
+		}
+
+		for _, known := range catalog {
+			if strings.EqualFold(value, known) {
+				return nil
+			}
+		}
+
+		severity := diag.Warning
+		summary := fmt.Sprintf("expected %s to be one of %v, got %s", field, catalog, value)
+		if !format.MatchString(value) {
+			severity = diag.Error
+			summary = fmt.Sprintf("invalid %s %q", field, value)
+		}
+
+		detail := ""
+		if suggestion := closestString(catalog, value); suggestion != "" {
+			severity = diag.Error
+			detail = fmt.Sprintf("Did you mean %q?", suggestion)
+		}
+
+		return diag.Diagnostics{{
+			Severity:      severity,
+			Summary:       summary,
+			Detail:        detail,
+			AttributePath: path,
+		}}
+	}
+}
+
+// validateStringFormat validates the value matches the expected format, e.g. for commercial types
+// which are not listed by the SDK and change too often to be kept in a catalog.
+func validateStringFormat(format *regexp.Regexp, field string) func(interface{}, cty.Path) diag.Diagnostics {
+	return func(i interface{}, path cty.Path) diag.Diagnostics {
+		value, isString := i.(string)
+		if !isString {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("expected type of %s to be string", field),
+				AttributePath: path,
+			}}
+		}
+
+		if !format.MatchString(value) {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("invalid %s %q", field, value),
+				AttributePath: path,
+			}}
+		}
+
+		return nil
+	}
+}
+
+// closestString returns the value of candidates closest to s, ignoring case, or an empty string if none is close enough.
+func closestString(candidates []string, s string) string {
+	const maxDistance = 2
+
+	closest := ""
+	closestDistance := maxDistance + 1
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(strings.ToLower(candidate), strings.ToLower(s))
+		if distance < closestDistance {
+			closest = candidate
+			closestDistance = distance
+		}
+	}
+	return closest
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
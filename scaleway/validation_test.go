@@ -3,6 +3,8 @@ package scaleway
 import (
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,3 +66,56 @@ func TestValidationUUIDWithLocalityWithInvalidUUIDReturnError(t *testing.T) {
 		assert.Len(errors, 1, uuid)
 	}
 }
+
+func TestValidateStringInCatalog(t *testing.T) {
+	assert := assert.New(t)
+	validate := validateStringInCatalog([]string{"fr-par-1", "nl-ams-1"}, zoneFormat, "zone")
+
+	assert.Empty(validate("fr-par-1", cty.Path{}))
+	assert.Empty(validate("FR-PAR-1", cty.Path{}))
+
+	diags := validate("pl-waw-9", cty.Path{})
+	assert.Len(diags, 1)
+	assert.Equal(diag.Warning, diags[0].Severity)
+
+	diags = validate("fr-par1", cty.Path{})
+	assert.Len(diags, 1)
+	assert.Equal(diag.Error, diags[0].Severity)
+	assert.Equal(`Did you mean "fr-par-1"?`, diags[0].Detail)
+
+	// Well-formed typos are rejected as well
+	diags = validate("fr-pqr-1", cty.Path{})
+	assert.Len(diags, 1)
+	assert.Equal(diag.Error, diags[0].Severity)
+	assert.Equal(`Did you mean "fr-par-1"?`, diags[0].Detail)
+
+	diags = validate(42, cty.Path{})
+	assert.Len(diags, 1)
+	assert.Equal(diag.Error, diags[0].Severity)
+}
+
+func TestValidateStringFormat(t *testing.T) {
+	assert := assert.New(t)
+	validate := validateStringFormat(commercialTypeFormat, "type")
+
+	assert.Empty(validate("DEV1-S", cty.Path{}))
+	assert.Empty(validate("H100-1-80G", cty.Path{}))
+	assert.Empty(validate("db-dev-s", cty.Path{}))
+
+	diags := validate("DEV1 S", cty.Path{})
+	assert.Len(diags, 1)
+	assert.Equal(diag.Error, diags[0].Severity)
+
+	diags = validate(42, cty.Path{})
+	assert.Len(diags, 1)
+	assert.Equal(diag.Error, diags[0].Severity)
+}
+
+func TestClosestString(t *testing.T) {
+	assert := assert.New(t)
+	catalog := []string{"DEV1-S", "DEV1-M", "GP1-XS"}
+
+	assert.Equal("DEV1-M", closestString(catalog, "dev1-m"))
+	assert.Equal("GP1-XS", closestString(catalog, "GP1-X"))
+	assert.Equal("", closestString(catalog, "PRO2-XXS"))
+}